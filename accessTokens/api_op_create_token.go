@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -28,6 +29,10 @@ type Token struct {
 
 	// Policy defines the access rules associated with the token,
 	// including allowed resources and permissions.
+	//
+	// For tokens returned by CreateNewAccessToken this is the
+	// effective policy reported by the server, or the requested
+	// policy when the server does not return one.
 	Policy Policy `json:"policy"`
 
 	// TTLSec specifies the time-to-live of the token in seconds.
@@ -43,6 +48,16 @@ type Token struct {
 	// Token is the actual secret value used for authentication
 	// in API requests.
 	Token string `json:"token"`
+
+	// IssuedAt is the time the token was created, as a Unix
+	// timestamp. It is only set by GetTokenDetails, and only
+	// when the API reports it.
+	IssuedAt int64 `json:"issuedAt,omitempty"`
+
+	// ExpiresAt is the time the token expires, as a Unix
+	// timestamp. It is only set by GetTokenDetails, and only
+	// when the API reports it.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
 }
 
 // AccessTokenManagement provides methods to create, revoke,
@@ -134,6 +149,11 @@ type CreateNewAccessTokenResponse struct {
 
 	// Token is the generated secret token value.
 	Token string `json:"token"`
+
+	// Policy is the effective policy applied by the server, if it
+	// echoes one back. It may differ from the requested policy when
+	// the server normalizes or augments it.
+	Policy *Policy `json:"policy,omitempty"`
 }
 
 // Permission represents a single access permission
@@ -263,7 +283,13 @@ func (t *AccessTokenManagement) CreateNewAccessToken(ctx context.Context, input
 	}
 
 	// Construct the HTTP request for the API endpoint.
-	url := fmt.Sprintf("%s/v1/access/tokens/create", t.baseURL)
+	url, err := common.JoinURL(t.baseURL, common.APIVersion, common.EndpointTokenCreate)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build create token request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, &errors.AnedyaError{
@@ -272,15 +298,14 @@ func (t *AccessTokenManagement) CreateNewAccessToken(ctx context.Context, input
 		}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	common.SetJSONHeaders(req)
 
 	// Send the HTTP request to the API server
 	resp, err := t.httpClient.Do(req)
 	if err != nil {
 		return nil, &errors.AnedyaError{
 			Message: "failed to execute create token request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
@@ -299,19 +324,30 @@ func (t *AccessTokenManagement) CreateNewAccessToken(ctx context.Context, input
 	err = json.Unmarshal(responseBody, &apiResp)
 	if err != nil {
 		return nil, &errors.AnedyaError{
-			Message: "failed to decode create token response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode create token response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    responseBody,
 		}
 	}
 
 	// Handle HTTP-level errors.
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, responseBody)
 	}
 
 	// Handle API-level errors.
 	if !apiResp.Success {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, responseBody)
+	}
+
+	common.CaptureDecoded(resp, responseBody)
+
+	// Prefer the effective policy returned by the server and fall
+	// back to the requested policy when none is returned.
+	policy := input.Policy
+	if apiResp.Policy != nil {
+		policy = *apiResp.Policy
 	}
 
 	// Construct and return the SDK Token object.
@@ -319,7 +355,7 @@ func (t *AccessTokenManagement) CreateNewAccessToken(ctx context.Context, input
 		tokenManagement: t,
 		TokenID:         apiResp.TokenID,
 		Token:           apiResp.Token,
-		Policy:          input.Policy,
+		Policy:          policy,
 		TTLSec:          input.TTLSec,
 	}, nil
 }
@@ -0,0 +1,70 @@
+package accesstokens_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	accesstokens "github.com/anedyaio/anedya-go-sdk/accessTokens"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+)
+
+func TestCreateNewAccessTokenPolicy(t *testing.T) {
+	requested := accesstokens.Policy{
+		Resources: map[string]interface{}{"nodes": []interface{}{"n1"}},
+		Allow:     []accesstokens.Permission{accesstokens.PermissionDataGetLatest},
+	}
+	effective := accesstokens.Policy{
+		Resources: map[string]interface{}{"nodes": []interface{}{"n1"}},
+		Allow: []accesstokens.Permission{
+			accesstokens.PermissionDataGetLatest,
+			accesstokens.PermissionHealthGetStatus,
+		},
+	}
+
+	tests := []struct {
+		name string
+		// echo is the policy returned by the server, nil to omit it.
+		echo *accesstokens.Policy
+		want accesstokens.Policy
+	}{
+		{name: "server returns a modified policy", echo: &effective, want: effective},
+		{name: "server omits the policy", want: requested},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenCreate, func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(&accesstokens.CreateNewAccessTokenResponse{
+					BaseResponse: accesstokens.BaseResponse{Success: true},
+					TokenID:      "tok-1",
+					Token:        "secret",
+					Policy:       tt.echo,
+				})
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			token, err := client.AccessTokenManagement.CreateNewAccessToken(context.Background(), &accesstokens.CreateNewAccessTokenRequest{
+				TTLSec: 3600,
+				Policy: requested,
+			})
+			if err != nil {
+				t.Fatalf("CreateNewAccessToken() error = %v", err)
+			}
+			if token.TokenID != "tok-1" || token.Token != "secret" || token.TTLSec != 3600 {
+				t.Fatalf("Token = %+v, want ID tok-1, secret and TTL 3600", token)
+			}
+			if !reflect.DeepEqual(token.Policy, tt.want) {
+				t.Fatalf("Policy = %+v, want %+v", token.Policy, tt.want)
+			}
+		})
+	}
+}
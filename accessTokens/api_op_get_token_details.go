@@ -0,0 +1,164 @@
+// Package accesstokens provides APIs to manage access tokens
+// in the Anedya platform.
+package accesstokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// GetTokenDetailsRequest represents the payload sent to the
+// Get Token Details API endpoint.
+type GetTokenDetailsRequest struct {
+
+	// TokenID is the unique identifier of the access token
+	// to inspect.
+	//
+	// This field is required.
+	TokenID string `json:"tokenId"`
+}
+
+// GetTokenDetailsResponse represents the response returned by
+// the Get Token Details API endpoint.
+type GetTokenDetailsResponse struct {
+	BaseResponse
+
+	// Data contains the token details.
+	Data Token `json:"data"`
+}
+
+// GetTokenDetails retrieves the policy, TTL and, when reported by
+// the API, the issue and expiry times of an existing access token.
+//
+// Input:
+//   - ctx: request context
+//   - tokenId: identifier of the token to inspect
+//
+// Output:
+//   - *Token on success; the secret Token value is not returned
+//   - error on failure
+//
+// The method performs the following steps:
+//
+//  1. Validates the input token identifier.
+//  2. Encodes the request payload as JSON.
+//  3. Builds and sends an HTTP request.
+//  4. Reads and decodes the API response.
+//  5. Maps API errors into structured SDK errors.
+//
+// A token that does not exist fails with an *errors.AnedyaError
+// matching errors.ErrAccessTokenNotFound and errors.ErrNotFound, as
+// well as errors.ErrInvalidToken for consistency with RevokeAccessToken.
+//
+// The endpoint path follows the naming of the create and revoke
+// endpoints; it is not listed in the published API reference.
+func (t *AccessTokenManagement) GetTokenDetails(ctx context.Context, tokenId string) (*Token, error) {
+	// Step 1: Validate the input token identifier.
+	if tokenId == "" {
+		return nil, &errors.AnedyaError{
+			Message: "tokenId is required",
+			Err:     errors.ErrTokenIdRequired,
+		}
+	}
+
+	// Step 2: Construct the request payload.
+	reqPayload := GetTokenDetailsRequest{
+		TokenID: tokenId,
+	}
+	requestBody, err := json.Marshal(reqPayload)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to encode token details request",
+			Err:     errors.ErrRequestEncodeFailed,
+		}
+	}
+
+	// Step 3: Build the HTTP request.
+	url, err := common.JoinURL(t.baseURL, common.APIVersion, common.EndpointTokenDetails)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build token details request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build token details request",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	common.SetJSONHeaders(req)
+
+	// Step 4: Execute the HTTP request.
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to execute token details request",
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
+		}
+	}
+	defer resp.Body.Close()
+
+	// Step 5: Read the response body.
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to read token details response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// Step 6: Decode the API response.
+	var apiResp GetTokenDetailsResponse
+	err = json.Unmarshal(responseBody, &apiResp)
+	if err != nil && resp.StatusCode != http.StatusNotFound {
+		return nil, &errors.AnedyaError{
+			Message:    "failed to decode token details response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    responseBody,
+		}
+	}
+
+	// Step 7: Handle HTTP-level and API-level errors.
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices || !apiResp.Success {
+		return nil, tokenDetailsError(apiResp.BaseResponse, resp.StatusCode, responseBody)
+	}
+
+	common.CaptureDecoded(resp, responseBody)
+
+	// Step 8: Return the token bound to this client.
+	token := apiResp.Data
+	token.tokenManagement = t
+	if token.TokenID == "" {
+		token.TokenID = tokenId
+	}
+	return &token, nil
+}
+
+// tokenDetailsError maps a failed Get Token Details response to an
+// SDK error, reporting missing tokens as errors.ErrAccessTokenNotFound.
+func tokenDetailsError(apiResp BaseResponse, statusCode int, body []byte) error {
+	err := errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, statusCode, body)
+
+	var apiErr *errors.AnedyaError
+	if stderrors.As(err, &apiErr) &&
+		(apiErr.ReasonCode == errors.ReasonTokenNotFound || statusCode == http.StatusNotFound) {
+		if apiErr.Message == "" {
+			apiErr.Message = "token not found"
+		}
+		apiErr.Err = fmt.Errorf("%w: %w", errors.ErrAccessTokenNotFound, errors.ErrInvalidToken)
+	}
+
+	return err
+}
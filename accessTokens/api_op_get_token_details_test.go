@@ -0,0 +1,129 @@
+package accesstokens_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	accesstokens "github.com/anedyaio/anedya-go-sdk/accessTokens"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGetTokenDetails(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var sent accesstokens.GetTokenDetailsRequest
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenDetails, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"policy": {"resources": {"nodes": ["n1"]}, "allow": ["data::getlatest"]},
+				"ttlSec": 3600,
+				"issuedAt": 1700000000,
+				"expiresAt": 1700003600
+			}
+		}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	token, err := client.AccessTokenManagement.GetTokenDetails(context.Background(), "tok-1")
+	if err != nil {
+		t.Fatalf("GetTokenDetails() error = %v", err)
+	}
+	if sent.TokenID != "tok-1" {
+		t.Fatalf("requested token %q, want tok-1", sent.TokenID)
+	}
+
+	if token.TokenID != "tok-1" || token.TTLSec != 3600 || token.IssuedAt != 1700000000 || token.ExpiresAt != 1700003600 {
+		t.Fatalf("Token = %+v, want ID tok-1, TTL 3600 and both timestamps", token)
+	}
+	if token.Token != "" {
+		t.Fatalf("Token.Token = %q, want the secret left empty", token.Token)
+	}
+	wantPolicy := accesstokens.Policy{
+		Resources: map[string]interface{}{"nodes": []interface{}{"n1"}},
+		Allow:     []accesstokens.Permission{"data::getlatest"},
+	}
+	if !reflect.DeepEqual(token.Policy, wantPolicy) {
+		t.Fatalf("Policy = %+v, want %+v", token.Policy, wantPolicy)
+	}
+}
+
+func TestGetTokenDetailsNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{
+			name:   "reason code",
+			status: http.StatusOK,
+			body:   `{"success":false,"error":"token not found","reasonCode":"` + string(errors.ReasonTokenNotFound) + `"}`,
+		},
+		{name: "404 without JSON", status: http.StatusNotFound, body: "404 page not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenDetails, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			token, err := client.AccessTokenManagement.GetTokenDetails(context.Background(), "missing")
+			if token != nil {
+				t.Fatalf("GetTokenDetails() = %+v, want nil", token)
+			}
+			for _, want := range []error{errors.ErrAccessTokenNotFound, errors.ErrNotFound, errors.ErrInvalidToken} {
+				if !stderrors.Is(err, want) {
+					t.Fatalf("GetTokenDetails() error = %v, want it to match %v", err, want)
+				}
+			}
+			if stderrors.Is(err, errors.ErrTokenNotFound) {
+				t.Fatalf("GetTokenDetails() error = %v, must not match the missing bearer token error", err)
+			}
+
+			var apiErr *errors.AnedyaError
+			if !stderrors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Fatalf("GetTokenDetails() error = %v, want an *AnedyaError with status %d", err, tt.status)
+			}
+		})
+	}
+}
+
+func TestGetTokenDetailsRequiresTokenID(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenDetails, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	_, err := client.AccessTokenManagement.GetTokenDetails(context.Background(), "")
+	if !stderrors.Is(err, errors.ErrTokenIdRequired) {
+		t.Fatalf("GetTokenDetails() error = %v, want %v", err, errors.ErrTokenIdRequired)
+	}
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want 0", requests)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -76,7 +77,13 @@ func (t *AccessTokenManagement) RevokeAccessToken(ctx context.Context, tokenId s
 	}
 
 	// Step 3: Build the HTTP request.
-	url := fmt.Sprintf("%s/v1/access/tokens/revoke", t.baseURL)
+	url, err := common.JoinURL(t.baseURL, common.APIVersion, common.EndpointTokenRevoke)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build revoke token request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return &errors.AnedyaError{
@@ -85,15 +92,14 @@ func (t *AccessTokenManagement) RevokeAccessToken(ctx context.Context, tokenId s
 		}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	common.SetJSONHeaders(req)
 
 	// Step 4: Execute the HTTP request.
 	resp, err := t.httpClient.Do(req)
 	if err != nil {
 		return &errors.AnedyaError{
 			Message: "failed to execute revoke token request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
@@ -107,27 +113,36 @@ func (t *AccessTokenManagement) RevokeAccessToken(ctx context.Context, tokenId s
 		}
 	}
 
+	// An empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, responseBody) {
+		return nil
+	}
+
 	// Step 6: Decode the API response.
 	var apiResp RevokeAccessTokenResponse
 
 	err = json.Unmarshal(responseBody, &apiResp)
 	if err != nil {
 		return &errors.AnedyaError{
-			Message: "failed to decode revoke token response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode revoke token response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    responseBody,
 		}
 	}
 
 	// Step 7: Handle HTTP-level errors.
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, responseBody)
 	}
 
 	// Step 8: Handle API-level errors.
 	if !apiResp.Success {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, responseBody)
 	}
 
+	common.CaptureDecoded(resp, responseBody)
+
 	// Step 9: Token successfully revoked.
 	return nil
 }
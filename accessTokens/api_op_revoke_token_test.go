@@ -0,0 +1,76 @@
+package accesstokens_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestRevokeAccessTokenJSONHeaders(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var header http.Header
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenRevoke, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	if err := client.AccessTokenManagement.RevokeAccessToken(context.Background(), "token-id"); err != nil {
+		t.Fatalf("RevokeAccessToken() error = %v", err)
+	}
+	if header == nil {
+		t.Fatal("no request reached the server")
+	}
+	for _, name := range []string{"Content-Type", "Accept"} {
+		if got := header.Values(name); len(got) != 1 || got[0] != "application/json" {
+			t.Fatalf("%s = %v, want [application/json]", name, got)
+		}
+	}
+}
+
+func TestRevokeAccessTokenEmptySuccessBodies(t *testing.T) {
+	bodies := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{name: "empty 200", status: http.StatusOK},
+		{name: "empty 204", status: http.StatusNoContent},
+		{name: "JSON success", status: http.StatusOK, body: `{"success":true}`},
+		{name: "empty error status", status: http.StatusInternalServerError, wantErr: errors.ErrResponseDecodeFailed},
+		{name: "JSON failure", status: http.StatusOK, body: `{"success":false,"error":"boom"}`, wantErr: errors.ErrUnknown},
+	}
+
+	for _, b := range bodies {
+		t.Run(b.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenRevoke, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(b.status)
+				w.Write([]byte(b.body))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			err := client.AccessTokenManagement.RevokeAccessToken(context.Background(), "token-id")
+			if b.wantErr == nil && err != nil {
+				t.Fatalf("RevokeAccessToken() error = %v, want nil", err)
+			}
+			if b.wantErr != nil && !stderrors.Is(err, b.wantErr) {
+				t.Fatalf("RevokeAccessToken() error = %v, want %v", err, b.wantErr)
+			}
+		})
+	}
+}
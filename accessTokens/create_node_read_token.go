@@ -0,0 +1,39 @@
+package accesstokens
+
+import (
+	"context"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// CreateNodeReadToken creates a least-privilege token that can only
+// read the data of a single node.
+//
+// The token is granted the latest, historical and snapshot data
+// permissions, scoped to nodeID, and expires after ttlSec seconds.
+// ttlSec is validated by CreateNewAccessToken and must be between
+// 1 and 7776000 (3 months).
+//
+// An empty nodeID fails with errors.ErrInvalidNode before any API
+// call is made.
+func (t *AccessTokenManagement) CreateNodeReadToken(ctx context.Context, nodeID string, ttlSec int) (*Token, error) {
+	if nodeID == "" {
+		return nil, &errors.AnedyaError{
+			Message: "node id is required",
+			Err:     errors.ErrInvalidNode,
+		}
+	}
+
+	policy, err := NewPolicyBuilder().
+		AllowData().
+		ForNodes(nodeID).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return t.CreateNewAccessToken(ctx, &CreateNewAccessTokenRequest{
+		TTLSec: ttlSec,
+		Policy: policy,
+	})
+}
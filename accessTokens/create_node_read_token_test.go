@@ -0,0 +1,97 @@
+package accesstokens_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	accesstokens "github.com/anedyaio/anedya-go-sdk/accessTokens"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestCreateNodeReadToken(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var sent map[string]interface{}
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenCreate, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		json.NewEncoder(w).Encode(&accesstokens.CreateNewAccessTokenResponse{
+			BaseResponse: accesstokens.BaseResponse{Success: true},
+			TokenID:      "tok-1",
+			Token:        "secret",
+		})
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	token, err := client.AccessTokenManagement.CreateNodeReadToken(context.Background(), "n1", 600)
+	if err != nil {
+		t.Fatalf("CreateNodeReadToken() error = %v", err)
+	}
+	if token.TokenID != "tok-1" || token.Token != "secret" || token.TTLSec != 600 {
+		t.Fatalf("Token = %+v, want ID tok-1, secret and TTL 600", token)
+	}
+
+	want := map[string]interface{}{
+		"ttlSec": float64(600),
+		"policy": map[string]interface{}{
+			"resources": map[string]interface{}{"nodes": []interface{}{"n1"}},
+			"allow": []interface{}{
+				string(accesstokens.PermissionDataGetLatest),
+				string(accesstokens.PermissionDataGetHistorical),
+				string(accesstokens.PermissionDataGetSnapshot),
+			},
+		},
+	}
+	if !reflect.DeepEqual(sent, want) {
+		t.Fatalf("sent %v, want %v", sent, want)
+	}
+}
+
+func TestCreateNodeReadTokenValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodeID  string
+		ttlSec  int
+		wantErr error
+	}{
+		{name: "no node", nodeID: "", ttlSec: 600, wantErr: errors.ErrInvalidNode},
+		{name: "zero ttl", nodeID: "n1", ttlSec: 0, wantErr: errors.ErrExpiryRequried},
+		{name: "ttl above three months", nodeID: "n1", ttlSec: 7776001, wantErr: errors.ErrExpiryRequried},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointTokenCreate, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := client.AccessTokenManagement.CreateNodeReadToken(context.Background(), tt.nodeID, tt.ttlSec)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateNodeReadToken() error = %v, want %v", err, tt.wantErr)
+			}
+			if token != nil {
+				t.Fatalf("CreateNodeReadToken() = %+v, want nil", token)
+			}
+		})
+	}
+
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want 0", requests)
+	}
+}
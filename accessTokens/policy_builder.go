@@ -0,0 +1,119 @@
+package accesstokens
+
+import (
+	"fmt"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// PolicyBuilder assembles a Policy step by step.
+//
+// Permissions are accumulated in the order they are added, with
+// duplicates removed. Validation happens in Build, so unknown
+// permissions are rejected before any API call is made.
+//
+//	policy, err := accesstokens.NewPolicyBuilder().
+//		AllowData().
+//		ForNodes(nodeID).
+//		Build()
+type PolicyBuilder struct {
+	allow []Permission
+	seen  map[Permission]bool
+	nodes []string
+}
+
+// NewPolicyBuilder returns an empty PolicyBuilder.
+func NewPolicyBuilder() *PolicyBuilder {
+	return &PolicyBuilder{
+		seen: make(map[Permission]bool),
+	}
+}
+
+// Allow grants the given permissions.
+func (b *PolicyBuilder) Allow(perms ...Permission) *PolicyBuilder {
+	for _, p := range perms {
+		if b.seen[p] {
+			continue
+		}
+		b.seen[p] = true
+		b.allow = append(b.allow, p)
+	}
+	return b
+}
+
+// AllowData grants every data read permission: latest,
+// historical and snapshot.
+func (b *PolicyBuilder) AllowData() *PolicyBuilder {
+	return b.Allow(
+		PermissionDataGetLatest,
+		PermissionDataGetHistorical,
+		PermissionDataGetSnapshot,
+	)
+}
+
+// AllowCommands grants every command permission: send,
+// list, get status and invalidate.
+func (b *PolicyBuilder) AllowCommands() *PolicyBuilder {
+	return b.Allow(
+		PermissionCmdSendCommand,
+		PermissionCmdListCommands,
+		PermissionCmdGetStatus,
+		PermissionCmdInvalidate,
+	)
+}
+
+// ForNodes restricts the policy to the given node IDs.
+//
+// The IDs are placed under the "nodes" key of the policy resources.
+// Calling ForNodes more than once appends to the node list.
+func (b *PolicyBuilder) ForNodes(ids ...string) *PolicyBuilder {
+	b.nodes = append(b.nodes, ids...)
+	return b
+}
+
+// Build validates the accumulated permissions and resources and
+// returns the resulting Policy.
+//
+// It fails with errors.ErrPolicyRequired when no permission was
+// granted, with errors.ErrInavalidPermission when a permission is
+// not known to the SDK, and with errors.ErrInvalidNode when a node
+// ID is empty.
+func (b *PolicyBuilder) Build() (Policy, error) {
+	if len(b.allow) == 0 {
+		return Policy{}, &errors.AnedyaError{
+			Message: "must contain at least one permission",
+			Err:     errors.ErrPolicyRequired,
+		}
+	}
+
+	for _, p := range b.allow {
+		if !isValidPermission(p) {
+			return Policy{}, &errors.AnedyaError{
+				Message: fmt.Sprintf("invalid permission %q", p),
+				Err:     errors.ErrInavalidPermission,
+			}
+		}
+	}
+
+	policy := Policy{
+		Allow: append([]Permission(nil), b.allow...),
+	}
+
+	if len(b.nodes) > 0 {
+		nodes := make([]string, 0, len(b.nodes))
+		for i, id := range b.nodes {
+			if id == "" {
+				return Policy{}, &errors.AnedyaError{
+					Message: fmt.Sprintf("node id at index %d is empty", i),
+					Err:     errors.ErrInvalidNode,
+				}
+			}
+			nodes = append(nodes, id)
+		}
+		policy.Resources = map[string]interface{}{
+			"nodes": nodes,
+		}
+	}
+
+	return policy, nil
+}
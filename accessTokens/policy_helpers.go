@@ -0,0 +1,75 @@
+package accesstokens
+
+// readOnlyPermissions lists the permissions that only read platform
+// data and never modify it.
+var readOnlyPermissions = []Permission{
+	PermissionDataGetLatest,
+	PermissionDataGetHistorical,
+	PermissionDataGetSnapshot,
+	PermissionCmdListCommands,
+	PermissionCmdGetStatus,
+	PermissionHealthGetStatus,
+	PermissionVSGetValue,
+	PermissionVSScanKeys,
+}
+
+// ReadOnlyPolicy returns a Policy granting read-only access to the
+// given resources.
+//
+// The policy allows reading data, command status, health status and
+// value store entries, and grants no permission that modifies
+// platform state. This is a convenient starting point for dashboard
+// tokens that must not be over-privileged.
+//
+// resources are collected into Policy.Resources in order. Each one is
+// either a map[string]interface{}, whose entries are merged, or a
+// string key followed by its value:
+//
+//	ReadOnlyPolicy("nodes", []string{nodeID})
+//	ReadOnlyPolicy(map[string]interface{}{"nodes": []string{nodeID}})
+//
+// Later entries replace earlier ones with the same key. A trailing key
+// without a value and arguments of any other type are ignored. Without
+// resources the policy carries no resource restriction.
+func ReadOnlyPolicy(resources ...interface{}) Policy {
+	allow := make([]Permission, 0, len(readOnlyPermissions))
+	for _, p := range readOnlyPermissions {
+		if isValidPermission(p) {
+			allow = append(allow, p)
+		}
+	}
+
+	return Policy{
+		Resources: collectResources(resources),
+		Allow:     allow,
+	}
+}
+
+// collectResources builds a resources map from maps and key/value
+// pairs, as accepted by ReadOnlyPolicy. It returns nil when no
+// resource is given.
+func collectResources(args []interface{}) map[string]interface{} {
+	var resources map[string]interface{}
+	set := func(key string, value interface{}) {
+		if resources == nil {
+			resources = make(map[string]interface{})
+		}
+		resources[key] = value
+	}
+
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i].(type) {
+		case map[string]interface{}:
+			for key, value := range arg {
+				set(key, value)
+			}
+		case string:
+			if i+1 < len(args) {
+				set(arg, args[i+1])
+				i++
+			}
+		}
+	}
+
+	return resources
+}
@@ -0,0 +1,93 @@
+package accesstokens_test
+
+import (
+	"reflect"
+	"testing"
+
+	accesstokens "github.com/anedyaio/anedya-go-sdk/accessTokens"
+)
+
+func TestReadOnlyPolicyAllow(t *testing.T) {
+	want := map[accesstokens.Permission]bool{
+		accesstokens.PermissionDataGetLatest:     true,
+		accesstokens.PermissionDataGetHistorical: true,
+		accesstokens.PermissionDataGetSnapshot:   true,
+		accesstokens.PermissionCmdListCommands:   true,
+		accesstokens.PermissionCmdGetStatus:      true,
+		accesstokens.PermissionHealthGetStatus:   true,
+		accesstokens.PermissionVSGetValue:        true,
+		accesstokens.PermissionVSScanKeys:        true,
+	}
+
+	mutating := []accesstokens.Permission{
+		accesstokens.PermissionCmdSendCommand,
+		accesstokens.PermissionCmdInvalidate,
+		accesstokens.PermissionVSSetValue,
+		accesstokens.PermissionVSDeleteKeys,
+	}
+
+	policy := accesstokens.ReadOnlyPolicy()
+
+	got := make(map[accesstokens.Permission]bool, len(policy.Allow))
+	for _, p := range policy.Allow {
+		if got[p] {
+			t.Errorf("Allow contains %q more than once", p)
+		}
+		got[p] = true
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Allow = %v, want exactly %v", policy.Allow, want)
+	}
+	for _, p := range mutating {
+		if got[p] {
+			t.Errorf("Allow contains mutating permission %q", p)
+		}
+	}
+}
+
+func TestReadOnlyPolicyResources(t *testing.T) {
+	nodes := []string{"n1", "n2"}
+
+	tests := []struct {
+		name      string
+		resources []interface{}
+		want      map[string]interface{}
+	}{
+		{
+			name: "no resources",
+			want: nil,
+		},
+		{
+			name:      "key value pair",
+			resources: []interface{}{"nodes", nodes},
+			want:      map[string]interface{}{"nodes": nodes},
+		},
+		{
+			name:      "map",
+			resources: []interface{}{map[string]interface{}{"nodes": nodes}},
+			want:      map[string]interface{}{"nodes": nodes},
+		},
+		{
+			name: "later entries replace earlier ones",
+			resources: []interface{}{
+				map[string]interface{}{"nodes": []string{"old"}, "groups": "g1"},
+				"nodes", nodes,
+			},
+			want: map[string]interface{}{"nodes": nodes, "groups": "g1"},
+		},
+		{
+			name:      "trailing key and other types are ignored",
+			resources: []interface{}{42, "nodes", nodes, "dangling"},
+			want:      map[string]interface{}{"nodes": nodes},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := accesstokens.ReadOnlyPolicy(tt.resources...).Resources
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Resources = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,18 +1,91 @@
 package anedya
 
 import (
-	"fmt"
+	"context"
 	"net/http"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
+// AuthProvider supplies the bearer token that is attached to
+// every outgoing API request.
+//
+// Implementations may fetch or refresh tokens on demand and must be
+// safe for concurrent use, since a single provider is shared by all
+// managers of a Client.
+type AuthProvider interface {
+	// Token returns the token to send in the Authorization header.
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is an AuthProvider that always returns the same token.
+type StaticToken string
+
+// Token implements AuthProvider.
+func (s StaticToken) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// authTokenKey is the context key holding a per-request token override.
+type authTokenKey struct{}
+
+// WithAuthToken returns a copy of ctx that makes requests issued with it
+// authenticate using token instead of the Client's AuthProvider.
+//
+// This lets a single Client act on behalf of different identities per
+// call. An empty token leaves the default provider in effect.
+func WithAuthToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, authTokenKey{}, token)
+}
+
+// authTransport is an http.RoundTripper that injects the
+// Authorization header on every request.
+//
+// A header already present on the request is left untouched so
+// callers can override authentication for individual requests.
 type authTransport struct {
-	apiKey string
-	next   http.RoundTripper
+	provider AuthProvider
+	next     http.RoundTripper
 }
 
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	newReq := req.Clone(req.Context())
-	newReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.apiKey))
-	newReq.Header.Set("Content-Type", "application/json")
+
+	if newReq.Header.Get("Authorization") == "" {
+		token, err := t.token(req.Context())
+		if err != nil {
+			closeRequestBody(req)
+			return nil, err
+		}
+
+		// never send a blank bearer token
+		if token == "" {
+			closeRequestBody(req)
+			return nil, &errors.AnedyaError{
+				Message: "auth provider returned an empty token",
+				Err:     errors.ErrTokenNotFound,
+			}
+		}
+
+		newReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
 	return t.next.RoundTrip(newReq)
 }
+
+// token returns the per-request override from ctx when present,
+// falling back to the configured provider.
+func (t *authTransport) token(ctx context.Context) (string, error) {
+	if token, ok := ctx.Value(authTokenKey{}).(string); ok && token != "" {
+		return token, nil
+	}
+	return t.provider.Token(ctx)
+}
+
+// closeRequestBody closes the request body when a RoundTripper
+// returns early, as required by the http.RoundTripper contract.
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
@@ -0,0 +1,125 @@
+package anedya_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestWithAuthToken(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var got string
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "override", ctx: anedya.WithAuthToken(context.Background(), "per-call"), want: "Bearer per-call"},
+		{name: "default after override", ctx: context.Background(), want: "Bearer anedyatest-api-key"},
+		{name: "empty override keeps default", ctx: anedya.WithAuthToken(context.Background(), ""), want: "Bearer anedyatest-api-key"},
+	}
+
+	// cases run in order so the second one proves the override does not stick
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := client.NodeManagement.DeleteNode(tt.ctx, &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+				t.Fatalf("DeleteNode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Authorization = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// tokenFunc adapts a function to anedya.AuthProvider.
+type tokenFunc func(ctx context.Context) (string, error)
+
+func (f tokenFunc) Token(ctx context.Context) (string, error) { return f(ctx) }
+
+func TestAuthProvider(t *testing.T) {
+	errProvider := stderrors.New("provider failed")
+
+	tests := []struct {
+		name     string
+		provider anedya.AuthProvider
+		ctx      context.Context
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "custom provider",
+			provider: tokenFunc(func(context.Context) (string, error) { return "rotated", nil }),
+			ctx:      context.Background(),
+			want:     "Bearer rotated",
+		},
+		{
+			name:     "empty token",
+			provider: anedya.StaticToken(""),
+			ctx:      context.Background(),
+			wantErr:  errors.ErrTokenNotFound,
+		},
+		{
+			name:     "provider error",
+			provider: tokenFunc(func(context.Context) (string, error) { return "", errProvider }),
+			ctx:      context.Background(),
+			wantErr:  errProvider,
+		},
+		{
+			name:     "existing header kept",
+			provider: anedya.StaticToken("default"),
+			ctx:      anedya.WithRequestOptions(context.Background(), anedya.WithHeader("Authorization", "Bearer caller")),
+			want:     "Bearer caller",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			got, called := "", false
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				got, called = r.Header.Get("Authorization"), true
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			client := mock.Client(anedya.WithAuthProvider(tt.provider))
+			defer client.Close()
+
+			err := client.NodeManagement.DeleteNode(tt.ctx, &nodes.DeleteNodeRequest{NodeID: "n1"})
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("DeleteNode() error = %v, want %v", err, tt.wantErr)
+				}
+				if called {
+					t.Fatal("request reached the server without a token")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteNode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Authorization = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
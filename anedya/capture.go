@@ -0,0 +1,47 @@
+package anedya
+
+import (
+	"net/http"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+)
+
+// RawResponseFunc receives the raw body of an API response that the
+// SDK decoded successfully, together with the request path it was
+// returned for, such as "/v1/node/list".
+//
+// The body slice is owned by the SDK and must not be modified or
+// retained after the function returns; copy it if needed.
+type RawResponseFunc func(endpoint string, body []byte)
+
+// captureTransport is an http.RoundTripper that enables raw response
+// capture for every 2xx response.
+//
+// It does not read the body itself. Instead it attaches the capture
+// function to the context of the response's request, and the manager
+// method hands over the body it has already read once decoding has
+// succeeded and the response reports no API error. Error responses
+// are passed through untouched.
+type captureTransport struct {
+	capture RawResponseFunc
+	next    http.RoundTripper
+}
+
+func (t *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, nil
+	}
+
+	respReq := resp.Request
+	if respReq == nil {
+		respReq = req
+	}
+	resp.Request = respReq.WithContext(common.WithCapture(respReq.Context(), common.CaptureFunc(t.capture)))
+
+	return resp, nil
+}
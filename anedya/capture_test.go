@@ -0,0 +1,58 @@
+package anedya_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestWithRawResponseCapture(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantCapture bool
+	}{
+		{name: "success", status: http.StatusOK, body: `{"success":true,"data":{}}`, wantCapture: true},
+		{name: "API error with 200", status: http.StatusOK, body: `{"success":false,"error":"nope"}`},
+		{name: "HTTP error", status: http.StatusBadRequest, body: `{"success":false,"error":"bad"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			path := "/" + common.APIVersion + "/" + common.EndpointNodeDetails
+			mock.Handle(path, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			var endpoints []string
+			var bodies []string
+			client := mock.Client(anedya.WithRawResponseCapture(func(endpoint string, body []byte) {
+				endpoints = append(endpoints, endpoint)
+				bodies = append(bodies, string(body))
+			}))
+			defer client.Close()
+
+			client.NodeManagement.GetNodeDetails(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+
+			if !tt.wantCapture {
+				if len(bodies) != 0 {
+					t.Fatalf("captured %q, want nothing", bodies)
+				}
+				return
+			}
+			if len(bodies) != 1 || bodies[0] != tt.body || endpoints[0] != path {
+				t.Fatalf("captured %q from %q, want %q from %q", bodies, endpoints, tt.body, path)
+			}
+		})
+	}
+}
@@ -21,18 +21,116 @@ type Client struct {
 	VariableManagement    *variable.VariableManagement
 	DataManagement        *dataAccess.DataManagement
 	AccessTokenManagement *accesstokens.AccessTokenManagement
+
+	// transport is the connection pool shared by all managers.
+	transport *http.Transport
 }
 
-func NewClient(baseURL, apiKey string) *Client {
+// NewClient creates a Client whose managers share a single HTTP client.
+//
+// Every request sent by the managers passes through a shared transport
+// which attaches the Authorization header. By default apiKey is used as
+// the bearer token; use WithAuthProvider to supply tokens dynamically.
+func NewClient(baseURL, apiKey string, opts ...Option) *Client {
+
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.authProvider == nil {
+		o.authProvider = StaticToken(apiKey)
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if o.tlsConfig != nil {
+		base.TLSClientConfig = o.tlsConfig.Clone()
+	}
+	if o.pinnedCertSHA256 != "" {
+		base.TLSClientConfig = pinnedTLSConfig(base.TLSClientConfig, o.pinnedCertSHA256)
+	}
+
+	var transport http.RoundTripper = base
+
+	if o.logger != nil {
+		transport = &loggingTransport{
+			logger: o.logger,
+			next:   transport,
+		}
+	}
+
+	if o.compression {
+		transport = &compressionTransport{
+			requestMinBytes: o.compressRequestMinSize,
+			next:            transport,
+		}
+	}
+
+	if o.rawResponseCapture != nil {
+		transport = &captureTransport{
+			capture: o.rawResponseCapture,
+			next:    transport,
+		}
+	}
 
-	auth := &authTransport{
-		apiKey: apiKey,
-		next:   http.DefaultTransport,
+	transport = &authTransport{
+		provider: o.authProvider,
+		next:     transport,
+	}
+
+	transport = &requestOptionsTransport{
+		baseURL: baseURL,
+		next:    transport,
+	}
+
+	if o.rateLimiter != nil {
+		transport = &rateLimitTransport{
+			limiter: o.rateLimiter,
+			next:    transport,
+		}
+	}
+
+	if o.retryMaxAttempts > 1 {
+		reasonCodes := make(map[string]bool, len(o.retryReasonCodes))
+		for _, code := range o.retryReasonCodes {
+			reasonCodes[code] = true
+		}
+
+		transport = &retryTransport{
+			maxAttempts: o.retryMaxAttempts,
+			baseDelay:   o.retryBaseDelay,
+			maxDelay:    maxRetryDelay,
+			reasonCodes: reasonCodes,
+			next:        transport,
+		}
+	}
+
+	clientTimeout := 30 * time.Second
+	if o.timeout > 0 {
+		transport = &timeoutTransport{
+			timeout: o.timeout,
+			next:    transport,
+		}
+		clientTimeout = 0
+	}
+
+	if len(o.interceptors) > 0 {
+		transport = &interceptorTransport{
+			interceptors: o.interceptors,
+			next:         transport,
+		}
+	}
+
+	if o.tracer != nil {
+		transport = &tracingTransport{
+			tracer: o.tracer,
+			next:   transport,
+		}
 	}
 
 	hc := &http.Client{
-		Timeout:   30 * time.Second,
-		Transport: auth,
+		Timeout:   clientTimeout,
+		Transport: transport,
 	}
 
 	return &Client{
@@ -40,7 +138,21 @@ func NewClient(baseURL, apiKey string) *Client {
 		VariableManagement:    variable.NewVariableManagement(hc, baseURL),
 		DataManagement:        dataAccess.NewDataManagement(hc, baseURL),
 		AccessTokenManagement: accesstokens.NewAccessTokenManagement(hc, baseURL),
+		transport:             base,
+	}
+}
+
+// Close releases the idle keep-alive connections held by the client.
+//
+// Call it once at shutdown, after all requests have finished. The
+// client and its managers must not be used afterward. Close always
+// returns nil; the error result allows Client to be used as an
+// io.Closer.
+func (c *Client) Close() error {
+	if c.transport != nil {
+		c.transport.CloseIdleConnections()
 	}
+	return nil
 }
 
 func DefaultURL(region AnedyaRegion) string {
@@ -0,0 +1,83 @@
+package anedya_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestClientClose(t *testing.T) {
+	var mu sync.Mutex
+	states := make(map[net.Conn]http.ConnState)
+	state := func() (idle, closed int) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, s := range states {
+			switch s {
+			case http.StateIdle:
+				idle++
+			case http.StateClosed:
+				closed++
+			}
+		}
+		return idle, closed
+	}
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true}`))
+	}))
+	srv.Config.ConnState = func(c net.Conn, s http.ConnState) {
+		mu.Lock()
+		states[c] = s
+		mu.Unlock()
+	}
+	srv.Start()
+	defer srv.Close()
+
+	client := anedya.NewClient(srv.URL, "token")
+
+	if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+		t.Fatalf("DeleteNode() error = %v", err)
+	}
+
+	// waitFor polls until cond holds, since connection state changes
+	// are reported asynchronously
+	waitFor := func(what string, cond func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for !cond() {
+			if time.Now().After(deadline) {
+				idle, closed := state()
+				t.Fatalf("timed out waiting for %s: %d idle and %d closed connections", what, idle, closed)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	waitFor("an idle keep-alive connection", func() bool {
+		idle, _ := state()
+		return idle == 1
+	})
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	waitFor("the idle connection to close", func() bool {
+		idle, closed := state()
+		return idle == 0 && closed == 1
+	})
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("second Close() error = %v", err)
+	}
+	if err := (&anedya.Client{}).Close(); err != nil {
+		t.Fatalf("Close() on a zero Client error = %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package anedya
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// compressionTransport is an http.RoundTripper that negotiates gzip
+// compressed responses and optionally compresses large request bodies.
+//
+// Setting Accept-Encoding explicitly disables the transparent
+// decompression of net/http, so gzip responses are decompressed here.
+// Responses sent without Content-Encoding: gzip, from servers that
+// ignore the header, are passed through unchanged.
+type compressionTransport struct {
+	// requestMinBytes is the body size from which requests are gzip
+	// compressed, or zero to never compress requests.
+	requestMinBytes int
+	next            http.RoundTripper
+}
+
+func (t *compressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	if t.requestMinBytes > 0 && req.Body != nil && req.Body != http.NoBody {
+		if err := t.compressRequest(req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, nil
+	}
+
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return nil, &errors.AnedyaError{
+			Message:    "failed to decompress gzip response",
+			Err:        errors.ErrResponseReadFailed,
+			StatusCode: resp.StatusCode,
+		}
+	}
+
+	resp.Body = &gzipBody{zr: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+
+	return resp, nil
+}
+
+// compressRequest gzip compresses the body of req when it is at least
+// requestMinBytes long.
+func (t *compressionTransport) compressRequest(req *http.Request) error {
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read request body for compression",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	if len(body) < t.requestMinBytes {
+		setRequestBody(req, body)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err == nil {
+		err = zw.Close()
+	}
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to compress request body",
+			Err:     errors.ErrRequestEncodeFailed,
+		}
+	}
+
+	setRequestBody(req, buf.Bytes())
+	req.Header.Set("Content-Encoding", "gzip")
+	return nil
+}
+
+// setRequestBody replaces the body of req with body, keeping
+// ContentLength and GetBody consistent with it so the request can
+// still be replayed, for example on redirects.
+func setRequestBody(req *http.Request, body []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+}
+
+// gzipBody reads a gzip compressed response body and closes both the
+// gzip reader and the underlying body.
+type gzipBody struct {
+	zr   *gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	return b.zr.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	b.zr.Close()
+	return b.body.Close()
+}
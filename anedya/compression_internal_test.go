@@ -0,0 +1,91 @@
+package anedya
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestCompressRequestGetBody(t *testing.T) {
+	payload := strings.Repeat(`{"nodeId":"n1"}`, 50)
+
+	tests := []struct {
+		name     string
+		minBytes int
+		wantGzip bool
+	}{
+		{name: "compressed", minBytes: 1, wantGzip: true},
+		{name: "below threshold", minBytes: len(payload) + 1, wantGzip: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent *http.Request
+			tr := &compressionTransport{
+				requestMinBytes: tt.minBytes,
+				next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					sent = req
+					return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+				}),
+			}
+
+			req, err := http.NewRequest(http.MethodPost, "https://api.example.com/v1/node/details", strings.NewReader(payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := tr.RoundTrip(req); err != nil {
+				t.Fatalf("RoundTrip() error = %v", err)
+			}
+
+			if gzipped := sent.Header.Get("Content-Encoding") == "gzip"; gzipped != tt.wantGzip {
+				t.Fatalf("Content-Encoding = %q, want gzip: %v", sent.Header.Get("Content-Encoding"), tt.wantGzip)
+			}
+
+			body, err := io.ReadAll(sent.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sent.ContentLength != int64(len(body)) {
+				t.Fatalf("ContentLength = %d, want the %d bytes sent", sent.ContentLength, len(body))
+			}
+
+			// GetBody must return a fresh copy of what was sent, twice
+			for i := 0; i < 2; i++ {
+				if sent.GetBody == nil {
+					t.Fatal("GetBody = nil")
+				}
+				rc, err := sent.GetBody()
+				if err != nil {
+					t.Fatalf("GetBody() error = %v", err)
+				}
+				again, _ := io.ReadAll(rc)
+				rc.Close()
+				if !bytes.Equal(again, body) {
+					t.Fatalf("GetBody() call %d returned %d bytes, want the %d bytes sent", i+1, len(again), len(body))
+				}
+			}
+
+			if tt.wantGzip {
+				zr, err := gzip.NewReader(bytes.NewReader(body))
+				if err != nil {
+					t.Fatalf("gzip.NewReader() error = %v", err)
+				}
+				body, err = io.ReadAll(zr)
+				if err != nil {
+					t.Fatalf("gunzip error = %v", err)
+				}
+			}
+			if string(body) != payload {
+				t.Fatalf("decoded body = %q, want %q", body, payload)
+			}
+		})
+	}
+}
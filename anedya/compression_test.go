@@ -0,0 +1,137 @@
+package anedya_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+const nodeDetailsBody = `{"success":true,"data":{"n1":{"nodeId":"n1","nodeName":"pump"}}}`
+
+func TestCompressionResponses(t *testing.T) {
+	tests := []struct {
+		name    string
+		write   func(w http.ResponseWriter, acceptEncoding string)
+		wantErr error
+	}{
+		{
+			name: "gzip response",
+			write: func(w http.ResponseWriter, acceptEncoding string) {
+				if acceptEncoding != "gzip" {
+					w.Write([]byte(`{"success":false,"error":"gzip not negotiated"}`))
+					return
+				}
+				w.Header().Set("Content-Encoding", "gzip")
+				zw := gzip.NewWriter(w)
+				zw.Write([]byte(nodeDetailsBody))
+				zw.Close()
+			},
+		},
+		{
+			name: "server ignores Accept-Encoding",
+			write: func(w http.ResponseWriter, acceptEncoding string) {
+				w.Write([]byte(nodeDetailsBody))
+			},
+		},
+		{
+			name: "corrupt gzip response",
+			write: func(w http.ResponseWriter, acceptEncoding string) {
+				w.Header().Set("Content-Encoding", "gzip")
+				w.Write([]byte(nodeDetailsBody))
+			},
+			wantErr: errors.ErrResponseReadFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDetails, func(w http.ResponseWriter, r *http.Request) {
+				tt.write(w, r.Header.Get("Accept-Encoding"))
+			})
+
+			client := mock.Client(anedya.WithCompression())
+			defer client.Close()
+
+			details, err := client.NodeManagement.GetNodeDetails(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("GetNodeDetails() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetNodeDetails() error = %v", err)
+			}
+			if got := details["n1"].NodeName; got != "pump" {
+				t.Fatalf("NodeName = %q, want %q", got, "pump")
+			}
+		})
+	}
+}
+
+func TestRequestCompression(t *testing.T) {
+	tests := []struct {
+		name     string
+		minBytes int
+		wantGzip bool
+	}{
+		{name: "body above threshold", minBytes: 1, wantGzip: true},
+		{name: "body below threshold", minBytes: 1 << 20, wantGzip: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var encoding string
+			var got nodes.GetNodeDetailsRequest
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDetails, func(w http.ResponseWriter, r *http.Request) {
+				encoding = r.Header.Get("Content-Encoding")
+
+				var body io.Reader = r.Body
+				if encoding == "gzip" {
+					zr, err := gzip.NewReader(r.Body)
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+					defer zr.Close()
+					body = zr
+				}
+				if err := json.NewDecoder(body).Decode(&got); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				w.Write([]byte(nodeDetailsBody))
+			})
+
+			client := mock.Client(anedya.WithRequestCompression(tt.minBytes))
+			defer client.Close()
+
+			_, err := client.NodeManagement.GetNodeDetails(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+			if err != nil {
+				t.Fatalf("GetNodeDetails() error = %v", err)
+			}
+			if (encoding == "gzip") != tt.wantGzip {
+				t.Fatalf("Content-Encoding = %q, want gzip: %v", encoding, tt.wantGzip)
+			}
+			if len(got.Nodes) != 1 || got.Nodes[0] != "n1" {
+				t.Fatalf("server decoded nodes %v, want [n1]", got.Nodes)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package anedya
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// Interceptor inspects or modifies API calls made by the client.
+//
+// BeforeRequest is called with the outgoing request before it is sent
+// and may modify it, for example to add tenant or idempotency headers.
+// A non-nil error aborts the call and is returned from the manager
+// method, wrapped with errors.ErrRequestFailed.
+//
+// AfterResponse is called with the response before the SDK reads it.
+// It must not consume the body. A non-nil error discards the response
+// and fails the call. Like any transport failure, the manager method
+// returns an *errors.AnedyaError wrapping errors.ErrRequestFailed; the
+// rejection itself, wrapping errors.ErrResponseRejected and the
+// interceptor's error, is found further down the chain with errors.Is
+// or errors.As:
+//
+//	if errors.Is(err, errors.ErrResponseRejected) {
+//		// an interceptor rejected the response
+//	}
+type Interceptor interface {
+	BeforeRequest(req *http.Request) error
+	AfterResponse(resp *http.Response) error
+}
+
+// interceptorTransport is an http.RoundTripper that runs interceptors
+// in registration order around every API call.
+//
+// Interceptors run once per call, around all of its retry attempts.
+type interceptorTransport struct {
+	interceptors []Interceptor
+	next         http.RoundTripper
+}
+
+func (t *interceptorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	// interceptors may modify the request, so give them a copy
+	req = req.Clone(req.Context())
+
+	for _, i := range t.interceptors {
+		if err := i.BeforeRequest(req); err != nil {
+			closeRequestBody(req)
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range t.interceptors {
+		if err := i.AfterResponse(resp); err != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			return nil, &errors.AnedyaError{
+				Message:    "response rejected by interceptor",
+				Err:        fmt.Errorf("%w: %w", errors.ErrResponseRejected, err),
+				StatusCode: resp.StatusCode,
+			}
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+package anedya_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// funcInterceptor adapts functions to anedya.Interceptor. Nil
+// functions do nothing.
+type funcInterceptor struct {
+	before func(req *http.Request) error
+	after  func(resp *http.Response) error
+}
+
+func (i funcInterceptor) BeforeRequest(req *http.Request) error {
+	if i.before == nil {
+		return nil
+	}
+	return i.before(req)
+}
+
+func (i funcInterceptor) AfterResponse(resp *http.Response) error {
+	if i.after == nil {
+		return nil
+	}
+	return i.after(resp)
+}
+
+func TestInterceptorMutatesRequest(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var tenant, idempotency string
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		tenant = r.Header.Get("X-Tenant")
+		idempotency = r.Header.Get("Idempotency-Key")
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	// interceptors run in registration order
+	client := mock.Client(
+		anedya.WithInterceptor(funcInterceptor{before: func(req *http.Request) error {
+			req.Header.Set("X-Tenant", "acme")
+			return nil
+		}}),
+		anedya.WithInterceptor(funcInterceptor{before: func(req *http.Request) error {
+			req.Header.Set("Idempotency-Key", req.Header.Get("X-Tenant")+"-1")
+			return nil
+		}}),
+	)
+	defer client.Close()
+
+	if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+		t.Fatalf("DeleteNode() error = %v", err)
+	}
+	if tenant != "acme" || idempotency != "acme-1" {
+		t.Fatalf("X-Tenant = %q, Idempotency-Key = %q, want acme and acme-1", tenant, idempotency)
+	}
+}
+
+func TestInterceptorShortCircuits(t *testing.T) {
+	errBlocked := stderrors.New("blocked")
+
+	tests := []struct {
+		name string
+		ic   funcInterceptor
+		// wantRequests is the number of requests reaching the server.
+		wantRequests int
+		wantErr      []error
+	}{
+		{
+			name:         "before request",
+			ic:           funcInterceptor{before: func(*http.Request) error { return errBlocked }},
+			wantRequests: 0,
+			wantErr:      []error{errors.ErrRequestFailed, errBlocked},
+		},
+		{
+			name:         "after response",
+			ic:           funcInterceptor{after: func(*http.Response) error { return errBlocked }},
+			wantRequests: 1,
+			wantErr:      []error{errors.ErrRequestFailed, errors.ErrResponseRejected, errBlocked},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			requests := 0
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			client := mock.Client(anedya.WithInterceptor(tt.ic))
+			defer client.Close()
+
+			err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"})
+			for _, want := range tt.wantErr {
+				if !stderrors.Is(err, want) {
+					t.Fatalf("DeleteNode() error = %v, want %v", err, want)
+				}
+			}
+			if requests != tt.wantRequests {
+				t.Fatalf("server received %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
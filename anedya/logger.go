@@ -0,0 +1,87 @@
+package anedya
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// Logger records the requests sent by the client and the responses
+// received for them.
+//
+// Only the method, URL, status, duration and error are passed to a
+// Logger. Request headers, including Authorization, and request or
+// response bodies, which may carry connection keys or token values,
+// are never exposed to it.
+type Logger interface {
+	// LogRequest is called before a request is sent.
+	LogRequest(method, url string)
+
+	// LogResponse is called once the request completes, with the HTTP
+	// status (zero when no response was received), the time taken and
+	// the transport error, if any.
+	LogResponse(status int, dur time.Duration, err error)
+}
+
+// NopLogger is a Logger that discards everything.
+type NopLogger struct{}
+
+// LogRequest implements Logger.
+func (NopLogger) LogRequest(method, url string) {}
+
+// LogResponse implements Logger.
+func (NopLogger) LogResponse(status int, dur time.Duration, err error) {}
+
+// StdLogger is a Logger that writes one line per request and
+// response to a standard library *log.Logger.
+type StdLogger struct {
+	l *log.Logger
+}
+
+// NewStdLogger returns a StdLogger writing to l, or to the standard
+// library's default logger when l is nil.
+func NewStdLogger(l *log.Logger) *StdLogger {
+	if l == nil {
+		l = log.Default()
+	}
+	return &StdLogger{l: l}
+}
+
+// LogRequest implements Logger.
+func (s *StdLogger) LogRequest(method, url string) {
+	s.l.Printf("anedya: request %s %s", method, url)
+}
+
+// LogResponse implements Logger.
+func (s *StdLogger) LogResponse(status int, dur time.Duration, err error) {
+	if err != nil {
+		s.l.Printf("anedya: response error after %s: %v", dur, err)
+		return
+	}
+	s.l.Printf("anedya: response %d in %s", status, dur)
+}
+
+// loggingTransport is an http.RoundTripper that reports every
+// request attempt to a Logger.
+//
+// It sits directly above the base transport, so each retry attempt
+// is logged separately. URLs are logged with any user info redacted.
+type loggingTransport struct {
+	logger Logger
+	next   http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.logger.LogRequest(req.Method, req.URL.Redacted())
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	t.logger.LogResponse(status, time.Since(start), err)
+
+	return resp, err
+}
@@ -0,0 +1,114 @@
+package anedya_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// recordingLogger is an anedya.Logger that records every call.
+type recordingLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) LogRequest(method, url string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, "request "+method+" "+url)
+}
+
+func (l *recordingLogger) LogResponse(status int, dur time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf("response %d %v", status, err))
+}
+
+func TestWithLogger(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	attempts := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	var logger recordingLogger
+	client := mock.Client(anedya.WithLogger(&logger), anedya.WithRetry(2, time.Millisecond))
+	defer client.Close()
+
+	if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+		t.Fatalf("DeleteNode() error = %v", err)
+	}
+
+	// every attempt is logged separately
+	url := mock.URL() + "/" + common.APIVersion + "/" + common.EndpointNodeDelete
+	want := []string{
+		"request POST " + url,
+		"response 503 <nil>",
+		"request POST " + url,
+		"response 200 <nil>",
+	}
+	if strings.Join(logger.lines, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("logged:\n%s\nwant:\n%s", strings.Join(logger.lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWithLoggerRedacts(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var auth []string
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	var buf bytes.Buffer
+	logger := anedya.NewStdLogger(log.New(&buf, "", 0))
+
+	// credentials in the base URL must not be logged either
+	bases := []string{
+		mock.URL(),
+		strings.Replace(mock.URL(), "://", "://user:url-secret@", 1),
+	}
+	for _, base := range bases {
+		client := anedya.NewClient(base, "api-key-secret", anedya.WithLogger(logger))
+		if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+			t.Fatalf("DeleteNode() with base %q error = %v", base, err)
+		}
+		client.Close()
+	}
+	if len(auth) == 0 || auth[0] != "Bearer api-key-secret" {
+		t.Fatalf("Authorization = %q, want the API key to be sent", auth)
+	}
+
+	out := buf.String()
+	if strings.Count(out, "anedya: request POST ") != 2 || strings.Count(out, "anedya: response 200 in ") != 2 {
+		t.Fatalf("log output %q, want two request and two response lines", out)
+	}
+	if !strings.Contains(out, "xxxxx@") {
+		t.Fatalf("log output %q, want the URL password redacted", out)
+	}
+	for _, secret := range []string{"api-key-secret", "url-secret", "Bearer", "Authorization"} {
+		if strings.Contains(out, secret) {
+			t.Fatalf("log output %q contains %q", out, secret)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package anedya
+
+import (
+	"crypto/tls"
+	"time"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*clientOptions)
+
+// clientOptions holds the settings collected from Option values.
+type clientOptions struct {
+	authProvider AuthProvider
+
+	retryMaxAttempts int
+	retryBaseDelay   time.Duration
+	retryReasonCodes []string
+
+	rateLimiter RateLimiter
+
+	timeout time.Duration
+
+	tlsConfig        *tls.Config
+	pinnedCertSHA256 string
+
+	compression            bool
+	compressRequestMinSize int
+
+	logger       Logger
+	tracer       Tracer
+	interceptors []Interceptor
+
+	rawResponseCapture RawResponseFunc
+}
+
+// WithAuthProvider sets the AuthProvider used to obtain the token
+// sent in the Authorization header of every request.
+//
+// When not set, the apiKey passed to NewClient is used as a static token.
+func WithAuthProvider(p AuthProvider) Option {
+	return func(o *clientOptions) {
+		o.authProvider = p
+	}
+}
+
+// WithRetry enables automatic retries of requests that fail with
+// transient errors: network timeouts, refused or reset connections,
+// connections closed before a response, and HTTP 429, 502, 503 or 504.
+//
+// maxAttempts is the total number of attempts including the first one,
+// and baseDelay is the delay before the first retry, doubled on every
+// subsequent retry up to a cap of 30 seconds. Each delay is randomly
+// jittered down by up to half. A Retry-After header returned by the
+// server takes precedence over the computed delay but is subject to
+// the same cap. Waiting between attempts stops as soon as the request
+// context is cancelled, and cancelled requests are never retried.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(o *clientOptions) {
+		o.retryMaxAttempts = maxAttempts
+		o.retryBaseDelay = baseDelay
+	}
+}
+
+// RetryOnReasonCode additionally retries requests whose response
+// carries one of the given API reason codes, even when the HTTP status
+// is successful.
+//
+// It only takes effect together with WithRetry, which controls the
+// number of attempts and the backoff. Response bodies are buffered to
+// inspect the reason code and remain readable by the SDK.
+func RetryOnReasonCode(codes ...string) Option {
+	return func(o *clientOptions) {
+		o.retryReasonCodes = append(o.retryReasonCodes, codes...)
+	}
+}
+
+// WithRateLimiter throttles all outgoing requests through r.
+//
+// Every request, including each retry attempt, waits for r before being
+// dispatched; waiting stops when the request context is cancelled.
+// Requests are not limited unless this option is set.
+func WithRateLimiter(r RateLimiter) Option {
+	return func(o *clientOptions) {
+		o.rateLimiter = r
+	}
+}
+
+// WithTimeout sets a default deadline for every request.
+//
+// The timeout is applied only when the context passed to a manager
+// method has no deadline of its own; an explicit caller deadline always
+// takes precedence and is never shortened. When set, the timeout covers
+// all retry attempts and reading the response, and replaces the default
+// 30 second HTTP client timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = d
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used by the client's
+// transport, for example to trust a custom certificate authority.
+//
+// cfg is cloned into the default transport built by NewClient, so
+// later changes to cfg do not affect the client.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(o *clientOptions) {
+		o.tlsConfig = cfg
+	}
+}
+
+// WithPinnedCertSHA256 rejects TLS connections whose leaf certificate
+// SHA-256 fingerprint does not match fingerprint.
+//
+// The fingerprint is the hex encoded digest of the DER encoded
+// certificate; colons and letter case are ignored. Pinning is applied
+// in addition to normal certificate verification and to any
+// configuration set with WithTLSConfig.
+func WithPinnedCertSHA256(fingerprint string) Option {
+	return func(o *clientOptions) {
+		o.pinnedCertSHA256 = fingerprint
+	}
+}
+
+// WithLogger reports every request attempt and its outcome to l.
+//
+// Retries are logged as separate attempts. Headers and bodies are never
+// logged; see Logger. No logging is performed unless this option is set.
+func WithLogger(l Logger) Option {
+	return func(o *clientOptions) {
+		o.logger = l
+	}
+}
+
+// WithTracer wraps every API call in a span started by t.
+//
+// Spans are named after the SDK method, for example
+// "anedya.nodes.GetNodeDetails", and cover all retry attempts of the
+// call. No spans are created unless this option is set.
+func WithTracer(t Tracer) Option {
+	return func(o *clientOptions) {
+		o.tracer = t
+	}
+}
+
+// WithInterceptor adds i to the interceptors run around every API call.
+//
+// The option may be given several times; interceptors run in the order
+// they were registered, both before the request and after the response.
+func WithInterceptor(i Interceptor) Option {
+	return func(o *clientOptions) {
+		o.interceptors = append(o.interceptors, i)
+	}
+}
+
+// WithCompression requests gzip compressed responses and decompresses
+// them transparently, reducing bandwidth for large GetData responses.
+//
+// Servers that ignore the request and reply with plain JSON are
+// handled as well.
+func WithCompression() Option {
+	return func(o *clientOptions) {
+		o.compression = true
+	}
+}
+
+// WithRequestCompression gzip compresses request bodies of at least
+// minBytes bytes and marks them with Content-Encoding: gzip.
+//
+// It implies WithCompression. Only enable it against servers that
+// accept compressed request bodies.
+func WithRequestCompression(minBytes int) Option {
+	return func(o *clientOptions) {
+		o.compression = true
+		o.compressRequestMinSize = minBytes
+	}
+}
+
+// WithRawResponseCapture calls fn with the request path and raw body
+// of every successful API response, after the SDK has decoded the body
+// without error. A 2xx response reporting "success": false is an API
+// error and is not captured.
+//
+// It helps diagnose responses that decode to zero values when the API
+// and the SDK's struct tags drift apart. Capture is off by default and
+// reuses the body the SDK has already read, so enabling it retains no
+// extra copies of response bodies.
+func WithRawResponseCapture(fn RawResponseFunc) Option {
+	return func(o *clientOptions) {
+		o.rawResponseCapture = fn
+	}
+}
@@ -0,0 +1,100 @@
+package anedya
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles outgoing requests.
+//
+// Wait blocks until a request may be sent or ctx is done.
+// *rate.Limiter from golang.org/x/time/rate satisfies this interface,
+// and NewTokenBucket provides a dependency-free implementation.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a token-bucket RateLimiter.
+//
+// Tokens are refilled continuously at a fixed rate up to the burst
+// size, and every request consumes one token.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a TokenBucket allowing rps requests per second
+// on average with bursts of up to burst requests.
+//
+// A burst smaller than 1 is treated as 1. A non-positive rps disables
+// limiting, making Wait return immediately.
+func NewTokenBucket(rps float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait implements RateLimiter.
+//
+// If ctx is done before a token becomes available, the reserved token
+// is returned to the bucket and the context error is returned.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if b.rate <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	// reserve a token, possibly going into debt
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, wait); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// rateLimitTransport is an http.RoundTripper that waits on a
+// RateLimiter before dispatching each request.
+type rateLimitTransport struct {
+	limiter RateLimiter
+	next    http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		closeRequestBody(req)
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,85 @@
+package anedya_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestRateLimiterDelaysBeyondBurst(t *testing.T) {
+	const (
+		rps   = 20
+		burst = 2
+		extra = 3
+	)
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var requests atomic.Int32
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client(anedya.WithRateLimiter(anedya.NewTokenBucket(rps, burst)))
+	defer client.Close()
+
+	start := time.Now()
+	for i := 0; i < burst+extra; i++ {
+		if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+			t.Fatalf("DeleteNode() error = %v", err)
+		}
+	}
+
+	// the burst is free, every further request waits for one refill
+	want := extra * time.Second / rps
+	if elapsed := time.Since(start); elapsed < want-10*time.Millisecond {
+		t.Fatalf("%d requests took %s, want at least %s", burst+extra, elapsed, want)
+	}
+	if got := requests.Load(); got != burst+extra {
+		t.Fatalf("server saw %d requests, want %d", got, burst+extra)
+	}
+}
+
+func TestRateLimiterCancelledWait(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var requests atomic.Int32
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	// one request per hour after the first
+	client := mock.Client(anedya.WithRateLimiter(anedya.NewTokenBucket(1.0/3600, 1)))
+	defer client.Close()
+
+	if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+		t.Fatalf("first DeleteNode() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.NodeManagement.DeleteNode(ctx, &nodes.DeleteNodeRequest{NodeID: "n1"})
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("second DeleteNode() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("wait returned after %s, want it to stop at the context deadline", elapsed)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("server saw %d requests, want 1", got)
+	}
+}
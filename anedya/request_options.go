@@ -0,0 +1,151 @@
+package anedya
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// RequestOption customizes a single API call.
+//
+// Request options are attached to a context with WithRequestOptions
+// and apply only to calls made with that context, unlike Option which
+// configures every call of a Client.
+type RequestOption func(*requestOptions)
+
+// requestOptions holds the per-call settings collected from
+// RequestOptions.
+type requestOptions struct {
+	headers http.Header
+	query   url.Values
+	baseURL string
+}
+
+// requestOptionsKey is the context key holding per-call options.
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx that applies opts to every
+// request issued with it, on top of any options already attached to ctx.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	o := &requestOptions{headers: make(http.Header), query: make(url.Values)}
+	if prev, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		o.headers = prev.headers.Clone()
+		o.query = cloneValues(prev.query)
+		o.baseURL = prev.baseURL
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return context.WithValue(ctx, requestOptionsKey{}, o)
+}
+
+// WithHeader sets the header key to value on the request, replacing
+// any value set by the SDK.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.headers.Set(key, value)
+	}
+}
+
+// WithQueryParam sets the query parameter key to value on the request
+// URL, replacing any value already present for key.
+func WithQueryParam(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.query.Set(key, value)
+	}
+}
+
+// WithBaseURLOverride sends the request to baseURL instead of the
+// base URL the Client was created with, for example to reach another
+// region. The endpoint path is preserved.
+func WithBaseURLOverride(baseURL string) RequestOption {
+	return func(o *requestOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+// requestOptionsTransport is an http.RoundTripper that applies the
+// per-call options attached to the request context.
+type requestOptionsTransport struct {
+	// baseURL is the Client base URL, used to find the endpoint path
+	// when the base URL is overridden.
+	baseURL string
+	next    http.RoundTripper
+}
+
+func (t *requestOptionsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	o, ok := req.Context().Value(requestOptionsKey{}).(*requestOptions)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+
+	for key, values := range o.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+
+	if len(o.query) > 0 {
+		q := req.URL.Query()
+		for key, values := range o.query {
+			q[key] = append([]string(nil), values...)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if o.baseURL != "" {
+		u, err := t.rebase(req.URL, o.baseURL)
+		if err != nil {
+			closeRequestBody(req)
+			return nil, err
+		}
+		req.URL = u
+		req.Host = ""
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// rebase moves the endpoint path of u from the Client base URL onto
+// baseURL, keeping the query string.
+func (t *requestOptionsTransport) rebase(u *url.URL, baseURL string) (*url.URL, error) {
+	target, err := url.Parse(baseURL)
+	if err != nil || target.Scheme == "" || target.Host == "" {
+		return nil, &errors.AnedyaError{
+			Message: "invalid base URL override " + baseURL,
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	// common.JoinURL does not repeat an API version that ends the base
+	// path, so that version belongs to the endpoint
+	endpoint := u.Path
+	if base, err := url.Parse(t.baseURL); err == nil {
+		basePath := strings.TrimRight(base.Path, "/")
+		basePath = strings.TrimSuffix(basePath, "/"+common.APIVersion)
+		endpoint = strings.TrimPrefix(endpoint, basePath)
+	}
+
+	rebased := *u
+	rebased.Scheme = target.Scheme
+	rebased.Host = target.Host
+	rebased.User = target.User
+	rebased.Path = strings.TrimSuffix(target.Path, "/") + "/" + strings.TrimPrefix(endpoint, "/")
+	rebased.RawPath = ""
+	return &rebased, nil
+}
+
+// cloneValues returns a deep copy of v.
+func cloneValues(v url.Values) url.Values {
+	c := make(url.Values, len(v))
+	for key, values := range v {
+		c[key] = append([]string(nil), values...)
+	}
+	return c
+}
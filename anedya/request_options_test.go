@@ -0,0 +1,132 @@
+package anedya_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestWithBaseURLOverride(t *testing.T) {
+	tests := []struct {
+		name       string
+		clientPath string
+	}{
+		{name: "client base without path"},
+		{name: "client base ending with the API version", clientPath: "/" + common.APIVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := anedyatest.NewServer()
+			defer home.Close()
+			other := anedyatest.NewServer()
+			defer other.Close()
+
+			var homeHits, otherHits int
+			home.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				homeHits++
+				w.Write([]byte(`{"success":true}`))
+			})
+			other.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				otherHits++
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			client := anedya.NewClient(home.URL()+tt.clientPath, "token")
+			defer client.Close()
+
+			ctx := anedya.WithRequestOptions(context.Background(), anedya.WithBaseURLOverride(other.URL()))
+			if err := client.NodeManagement.DeleteNode(ctx, &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+				t.Fatalf("DeleteNode() error = %v", err)
+			}
+			if homeHits != 0 || otherHits != 1 {
+				t.Fatalf("client base served %d requests and override %d, want 0 and 1", homeHits, otherHits)
+			}
+		})
+	}
+}
+
+func TestRequestOptionsHeadersAndQuery(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var header http.Header
+	var query url.Values
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		query = r.URL.Query()
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	outer := anedya.WithRequestOptions(context.Background(),
+		anedya.WithHeader("X-Request-Source", "outer"),
+		anedya.WithQueryParam("region", "eu"),
+	)
+	inner := anedya.WithRequestOptions(outer,
+		anedya.WithHeader("X-Trace", "abc"),
+		anedya.WithHeader("Accept", "application/vnd.anedya+json"),
+		anedya.WithQueryParam("region", "in"),
+		anedya.WithQueryParam("debug", "1"),
+	)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantHeader map[string]string // "" means the header must be absent
+		wantQuery  url.Values
+	}{
+		{
+			name:       "no options",
+			ctx:        context.Background(),
+			wantHeader: map[string]string{"X-Request-Source": "", "X-Trace": "", "Accept": "application/json"},
+			wantQuery:  url.Values{},
+		},
+		{
+			name:       "outer options",
+			ctx:        outer,
+			wantHeader: map[string]string{"X-Request-Source": "outer", "X-Trace": "", "Accept": "application/json"},
+			wantQuery:  url.Values{"region": {"eu"}},
+		},
+		{
+			name:       "inner options extend and override outer",
+			ctx:        inner,
+			wantHeader: map[string]string{"X-Request-Source": "outer", "X-Trace": "abc", "Accept": "application/vnd.anedya+json"},
+			wantQuery:  url.Values{"region": {"in"}, "debug": {"1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, query = nil, nil
+			if err := client.NodeManagement.DeleteNode(tt.ctx, &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+				t.Fatalf("DeleteNode() error = %v", err)
+			}
+
+			for name, want := range tt.wantHeader {
+				got := header.Values(name)
+				if want == "" {
+					if len(got) != 0 {
+						t.Fatalf("%s = %v, want it absent", name, got)
+					}
+					continue
+				}
+				if len(got) != 1 || got[0] != want {
+					t.Fatalf("%s = %v, want [%s]", name, got, want)
+				}
+			}
+			if !reflect.DeepEqual(query, tt.wantQuery) {
+				t.Fatalf("query = %v, want %v", query, tt.wantQuery)
+			}
+		})
+	}
+}
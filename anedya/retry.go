@@ -0,0 +1,237 @@
+package anedya
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// maxRetryDelay caps the delay between two attempts, including delays
+// requested by a Retry-After header.
+const maxRetryDelay = 30 * time.Second
+
+// retryTransport is an http.RoundTripper that retries requests
+// failing with transient errors using exponential backoff.
+//
+// A request is retried when:
+//   - the underlying transport returns a net.Error timeout, a refused
+//     or failed dial, a connection reset, or a connection closed
+//     before the response was read,
+//   - the response status is 429, 502, 503 or 504, or
+//   - the response body carries one of the configured reason codes.
+//
+// All Anedya API operations are POST requests that are safe to
+// replay, so the request body is buffered once and rewound from
+// the buffered copy on every attempt. Errors caused by the request
+// context being cancelled or expiring are never retried.
+type retryTransport struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	// maxDelay caps the delay between two attempts.
+	maxDelay    time.Duration
+	reasonCodes map[string]bool
+	next        http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	// buffer the body so every attempt sends the full payload
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, &errors.AnedyaError{
+				Message: "failed to buffer request body for retry",
+				Err:     errors.ErrRequestBuildFailed,
+			}
+		}
+		body = b
+	}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req.Clone(req.Context())
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+			attemptReq.ContentLength = int64(len(body))
+		}
+
+		resp, err = t.next.RoundTrip(attemptReq)
+
+		var reasonCode string
+		if err == nil && len(t.reasonCodes) > 0 {
+			reasonCode, err = peekReasonCode(resp)
+			if err != nil {
+				return nil, &errors.AnedyaError{
+					Message:    "failed to read response for retry",
+					Err:        errors.ErrResponseReadFailed,
+					StatusCode: resp.StatusCode,
+				}
+			}
+		}
+
+		if attempt >= t.maxAttempts || !t.shouldRetry(resp, err, reasonCode) {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt, resp)
+
+		// discard the failed response before retrying
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if waitErr := sleepContext(req.Context(), delay); waitErr != nil {
+			return nil, waitErr
+		}
+	}
+}
+
+// shouldRetry reports whether a request that produced resp and err
+// is worth retrying. reasonCode is the reason code decoded from the
+// response body, if any.
+func (t *retryTransport) shouldRetry(resp *http.Response, err error, reasonCode string) bool {
+	if err != nil {
+		return isTransientError(err)
+	}
+
+	if reasonCode != "" && t.reasonCodes[reasonCode] {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransientError reports whether err, returned by the underlying
+// transport, is a network failure that may succeed on another attempt.
+func isTransientError(err error) bool {
+	if stderrors.Is(err, context.Canceled) || stderrors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	if stderrors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	// the server refused, reset or dropped the connection
+	if stderrors.Is(err, syscall.ECONNREFUSED) ||
+		stderrors.Is(err, syscall.ECONNRESET) ||
+		stderrors.Is(err, io.ErrUnexpectedEOF) ||
+		stderrors.Is(err, io.EOF) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return stderrors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// peekReasonCode decodes the reason code from the response body
+// and restores the body so it can still be read by the caller.
+//
+// A body that is not a JSON object yields an empty reason code.
+func peekReasonCode(resp *http.Response) (string, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var apiResp struct {
+		ReasonCode string `json:"reasonCode"`
+	}
+	if json.Unmarshal(body, &apiResp) != nil {
+		return "", nil
+	}
+
+	return apiResp.ReasonCode, nil
+}
+
+// backoff returns the delay before the next attempt.
+//
+// A Retry-After header on the response takes precedence over the
+// exponential delay derived from baseDelay. Both are capped at
+// maxDelay, and the exponential delay is jittered to between half and
+// all of its value, so clients failing together do not retry in
+// lockstep.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(d, t.maxDelay)
+		}
+	}
+
+	if t.baseDelay <= 0 {
+		return 0
+	}
+
+	// double step by step so large attempt counts cannot overflow
+	delay := t.baseDelay
+	for i := 1; i < attempt && delay < t.maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, t.maxDelay)
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// parseRetryAfter parses a Retry-After header value given either
+// in seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(v); err == nil {
+		d := time.Until(at)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+package anedya
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestBackoffRetryAfter(t *testing.T) {
+	tr := &retryTransport{baseDelay: time.Millisecond, maxDelay: 5 * time.Second}
+
+	tests := []struct {
+		name       string
+		retryAfter string
+		want       time.Duration
+	}{
+		{name: "seconds", retryAfter: "2", want: 2 * time.Second},
+		{name: "zero", retryAfter: "0", want: 0},
+		{name: "clamped to max delay", retryAfter: "3600", want: 5 * time.Second},
+		{name: "date clamped to max delay", retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), want: 5 * time.Second},
+		{name: "date in the past", retryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{"Retry-After": {tt.retryAfter}}}
+			if got := tr.backoff(1, resp); got != tt.want {
+				t.Fatalf("backoff() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffExponential(t *testing.T) {
+	tr := &retryTransport{baseDelay: 100 * time.Millisecond, maxDelay: time.Second}
+
+	for attempt, max := range map[int]time.Duration{
+		1:  100 * time.Millisecond,
+		2:  200 * time.Millisecond,
+		3:  400 * time.Millisecond,
+		5:  time.Second,
+		64: time.Second,
+	} {
+		got := tr.backoff(attempt, nil)
+		if got < max/2 || got > max {
+			t.Fatalf("backoff(%d) = %s, want between %s and %s", attempt, got, max/2, max)
+		}
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	dial := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.EHOSTUNREACH)}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "connection refused", err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, want: true},
+		{name: "connection reset", err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, want: true},
+		{name: "dial failure", err: dial, want: true},
+		{name: "unexpected EOF", err: fmt.Errorf("reading response: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "EOF", err: io.EOF, want: true},
+		{name: "timeout", err: &url.Error{Op: "Post", Err: timeoutError{}}, want: true},
+		{name: "context cancelled", err: &url.Error{Op: "Post", Err: context.Canceled}, want: false},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, want: false},
+		{name: "other error", err: fmt.Errorf("malformed response"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransientError(tt.err); got != tt.want {
+				t.Fatalf("isTransientError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// timeoutError is a net.Error reporting a timeout.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
@@ -0,0 +1,242 @@
+package anedya_test
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestRetryOnReasonCode(t *testing.T) {
+	const busy = "backend::busy"
+
+	tests := []struct {
+		name string
+		// the first failures attempts answer with reason code code
+		code         string
+		failures     int
+		opts         []anedya.Option
+		wantAttempts int32
+		wantErr      error
+	}{
+		{
+			name:         "retryable code is retried",
+			code:         busy,
+			failures:     1,
+			opts:         []anedya.Option{anedya.RetryOnReasonCode(busy)},
+			wantAttempts: 2,
+		},
+		{
+			name:         "retryable code gives up after max attempts",
+			code:         busy,
+			failures:     5,
+			opts:         []anedya.Option{anedya.RetryOnReasonCode(busy)},
+			wantAttempts: 3,
+			wantErr:      errors.ErrUnknown,
+		},
+		{
+			name:         "non-retryable code is not retried",
+			code:         string(errors.ReasonNodeNotFound),
+			failures:     1,
+			opts:         []anedya.Option{anedya.RetryOnReasonCode(busy)},
+			wantAttempts: 1,
+			wantErr:      errors.ErrNodeNotFound,
+		},
+		{
+			name:         "reason codes are not retried by default",
+			code:         busy,
+			failures:     1,
+			wantAttempts: 1,
+			wantErr:      errors.ErrUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var attempts atomic.Int32
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				if int(attempts.Add(1)) <= tt.failures {
+					w.Write([]byte(`{"success":false,"error":"try again","reasonCode":"` + tt.code + `"}`))
+					return
+				}
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			opts := append([]anedya.Option{anedya.WithRetry(3, time.Millisecond)}, tt.opts...)
+			client := mock.Client(opts...)
+			defer client.Close()
+
+			err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"})
+
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Fatalf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("DeleteNode() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteNode() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryStatusCodes(t *testing.T) {
+	tests := []struct {
+		status       int
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{status: http.StatusTooManyRequests, wantAttempts: 2},
+		{status: http.StatusBadGateway, wantAttempts: 2},
+		{status: http.StatusServiceUnavailable, wantAttempts: 2},
+		{status: http.StatusGatewayTimeout, wantAttempts: 2},
+		{status: http.StatusInternalServerError, wantAttempts: 1, wantErr: true},
+		{status: http.StatusBadRequest, wantAttempts: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var attempts atomic.Int32
+			var bodies []string
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(b))
+				if attempts.Add(1) == 1 {
+					w.WriteHeader(tt.status)
+					w.Write([]byte(`{"success":false,"error":"unavailable"}`))
+					return
+				}
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			client := mock.Client(anedya.WithRetry(3, time.Millisecond))
+			defer client.Close()
+
+			err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteNode() error = %v, want error: %v", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Fatalf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+
+			// every attempt must carry the full request body
+			for i, b := range bodies {
+				if !strings.Contains(b, `"n1"`) {
+					t.Fatalf("attempt %d body = %q, want the node ID", i+1, b)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var attempts atomic.Int32
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	// the base delay alone would retry almost immediately
+	client := mock.Client(anedya.WithRetry(2, time.Millisecond))
+	defer client.Close()
+
+	start := time.Now()
+	if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+		t.Fatalf("DeleteNode() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("retried after %s, want at least the 1s Retry-After", elapsed)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("attempts = %d, want 2", got)
+	}
+}
+
+func TestRetryStopsOnCancel(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var attempts atomic.Int32
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client := mock.Client(anedya.WithRetry(5, time.Hour))
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.NodeManagement.DeleteNode(ctx, &nodes.DeleteNodeRequest{NodeID: "n1"})
+	}()
+
+	select {
+	case err := <-done:
+		if !stderrors.Is(err, context.Canceled) {
+			t.Fatalf("DeleteNode() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DeleteNode() still waiting to retry after the context was cancelled")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Fatalf("attempts = %d, want 1", got)
+	}
+}
+
+func TestRetryDroppedConnection(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var attempts atomic.Int32
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client(anedya.WithRetry(3, time.Millisecond))
+	defer client.Close()
+
+	if err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"}); err != nil {
+		t.Fatalf("DeleteNode() error = %v", err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("attempts = %d, want 2", got)
+	}
+}
@@ -0,0 +1,64 @@
+package anedya_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestWithTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		// ctxTimeout is the caller's deadline, zero for none.
+		ctxTimeout time.Duration
+	}{
+		{name: "client timeout", timeout: 20 * time.Millisecond},
+		{name: "shorter caller deadline wins", timeout: time.Hour, ctxTimeout: 20 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			// answer only once the test is over
+			release := make(chan struct{})
+			defer close(release)
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				<-release
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			client := mock.Client(anedya.WithTimeout(tt.timeout))
+			defer client.Close()
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			err := client.NodeManagement.DeleteNode(ctx, &nodes.DeleteNodeRequest{NodeID: "n1"})
+			if !stderrors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("DeleteNode() error = %v, want %v", err, context.DeadlineExceeded)
+			}
+			if !stderrors.Is(err, errors.ErrRequestFailed) {
+				t.Fatalf("DeleteNode() error = %v, want %v", err, errors.ErrRequestFailed)
+			}
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Fatalf("DeleteNode() returned after %s, want the 20ms deadline", elapsed)
+			}
+		})
+	}
+}
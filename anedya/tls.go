@@ -0,0 +1,54 @@
+package anedya
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"strings"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// pinnedTLSConfig returns a copy of base that additionally rejects
+// connections whose leaf certificate SHA-256 fingerprint does not
+// match fingerprint.
+//
+// The fingerprint is the hex encoded digest of the DER certificate;
+// colons and letter case are ignored. Any VerifyConnection callback
+// already present on base still runs before the pin is checked.
+func pinnedTLSConfig(base *tls.Config, fingerprint string) *tls.Config {
+	cfg := base.Clone()
+	if cfg == nil {
+		cfg = &tls.Config{}
+	}
+
+	want := strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+	next := cfg.VerifyConnection
+
+	cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+		if next != nil {
+			if err := next(cs); err != nil {
+				return err
+			}
+		}
+
+		if len(cs.PeerCertificates) == 0 {
+			return &errors.AnedyaError{
+				Message: "server presented no certificate",
+				Err:     errors.ErrCertificatePinMismatch,
+			}
+		}
+
+		sum := sha256.Sum256(cs.PeerCertificates[0].Raw)
+		if hex.EncodeToString(sum[:]) != want {
+			return &errors.AnedyaError{
+				Message: "server certificate does not match pinned fingerprint",
+				Err:     errors.ErrCertificatePinMismatch,
+			}
+		}
+
+		return nil
+	}
+
+	return cfg
+}
@@ -0,0 +1,69 @@
+package anedya_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestPinnedCertSHA256(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+
+	sum := sha256.Sum256(srv.Certificate().Raw)
+	pin := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name    string
+		pin     string
+		wantErr error
+	}{
+		{name: "matching pin", pin: pin},
+		{name: "matching pin with colons and upper case", pin: colonHex(strings.ToUpper(pin))},
+		{name: "mismatched pin", pin: strings.Repeat("00", sha256.Size), wantErr: errors.ErrCertificatePinMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := anedya.NewClient(srv.URL, "token",
+				anedya.WithTLSConfig(&tls.Config{RootCAs: roots}),
+				anedya.WithPinnedCertSHA256(tt.pin),
+			)
+			defer client.Close()
+
+			_, err := client.NodeManagement.GetNodeDetails(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("GetNodeDetails() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("GetNodeDetails() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// colonHex formats a hex string as colon separated byte pairs.
+func colonHex(s string) string {
+	pairs := make([]string, 0, len(s)/2)
+	for i := 0; i+1 < len(s); i += 2 {
+		pairs = append(pairs, s[i:i+2])
+	}
+	return strings.Join(pairs, ":")
+}
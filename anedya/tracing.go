@@ -0,0 +1,93 @@
+package anedya
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// Tracer starts spans around SDK API calls.
+//
+// StartSpan returns a context carrying the span, which is attached to
+// the outgoing request, and a function that ends the span. Tracers that
+// also implement TraceInjector have their headers written to the
+// request so the trace and its baggage propagate to the server. The end function is
+// called exactly once with the call's error, or nil on success.
+//
+// The interface is small enough to be backed by an OpenTelemetry
+// tracer through a thin adapter.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, func(err error))
+}
+
+// TraceInjector is optionally implemented by a Tracer to write trace
+// context and baggage headers, such as traceparent, into outgoing
+// requests. An OpenTelemetry adapter can implement it with a
+// propagation.TextMapPropagator.
+type TraceInjector interface {
+	Inject(ctx context.Context, header http.Header)
+}
+
+// NopTracer is a Tracer that records nothing.
+type NopTracer struct{}
+
+// StartSpan implements Tracer.
+func (NopTracer) StartSpan(ctx context.Context, name string) (context.Context, func(err error)) {
+	return ctx, func(error) {}
+}
+
+// spanName returns the span name for a request path.
+//
+// Endpoints known to the SDK are named after the operation calling
+// them, for example "anedya.nodes.GetNodeList". Unknown endpoints are
+// named after their path, for example "anedya.v1.health.status".
+func spanName(path string) string {
+	prefix := "/" + common.APIVersion + "/"
+	if i := strings.Index(path, prefix); i >= 0 {
+		if op, ok := common.EndpointOperation(path[i+len(prefix):]); ok {
+			return "anedya." + op
+		}
+	}
+	return "anedya" + strings.ReplaceAll(path, "/", ".")
+}
+
+// tracingTransport is an http.RoundTripper that wraps every API call,
+// including all of its retry attempts, in a span.
+//
+// Non-2xx responses end the span with an *errors.AnedyaError carrying
+// the HTTP status code, so tracers can record the status with errors.As.
+type tracingTransport struct {
+	tracer Tracer
+	next   http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, end := t.tracer.StartSpan(req.Context(), spanName(req.URL.Path))
+
+	req = req.WithContext(ctx)
+	if injector, ok := t.tracer.(TraceInjector); ok {
+		req.Header = req.Header.Clone()
+		injector.Inject(ctx, req.Header)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+
+	switch {
+	case err != nil:
+		end(err)
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		end(&errors.AnedyaError{
+			Message:    fmt.Sprintf("unexpected HTTP status %d", resp.StatusCode),
+			Err:        errors.ErrRequestFailed,
+			StatusCode: resp.StatusCode,
+		})
+	default:
+		end(nil)
+	}
+
+	return resp, err
+}
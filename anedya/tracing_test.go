@@ -0,0 +1,148 @@
+package anedya_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// spanKey is the context key under which recordingTracer stores the
+// name of the active span.
+type spanKey struct{}
+
+// span is a span recorded by recordingTracer.
+type span struct {
+	name  string
+	ended int
+	err   error
+}
+
+// recordingTracer is an anedya.Tracer and anedya.TraceInjector that
+// records its spans.
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*span
+}
+
+func (tr *recordingTracer) StartSpan(ctx context.Context, name string) (context.Context, func(err error)) {
+	s := &span{name: name}
+	tr.mu.Lock()
+	tr.spans = append(tr.spans, s)
+	tr.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, name), func(err error) {
+		tr.mu.Lock()
+		defer tr.mu.Unlock()
+		s.ended++
+		s.err = err
+	}
+}
+
+func (tr *recordingTracer) Inject(ctx context.Context, header http.Header) {
+	if name, ok := ctx.Value(spanKey{}).(string); ok {
+		header.Set("Traceparent", name)
+	}
+}
+
+// requestRecorder is an anedya.Interceptor recording the method, URL
+// and active span of the requests it sees.
+type requestRecorder struct {
+	method, url, span string
+}
+
+func (r *requestRecorder) BeforeRequest(req *http.Request) error {
+	r.method, r.url = req.Method, req.URL.String()
+	r.span, _ = req.Context().Value(spanKey{}).(string)
+	return nil
+}
+
+func (r *requestRecorder) AfterResponse(resp *http.Response) error { return nil }
+
+func TestWithTracer(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus int
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "error status", status: http.StatusNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var traceparent string
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDelete, func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("Traceparent")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			var tracer recordingTracer
+			var rec requestRecorder
+			client := mock.Client(anedya.WithTracer(&tracer), anedya.WithInterceptor(&rec))
+			defer client.Close()
+
+			client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"})
+
+			if len(tracer.spans) != 1 {
+				t.Fatalf("started %d spans, want 1", len(tracer.spans))
+			}
+			s := tracer.spans[0]
+			if s.name != "anedya.nodes.DeleteNode" {
+				t.Fatalf("span name = %q, want %q", s.name, "anedya.nodes.DeleteNode")
+			}
+			if s.ended != 1 {
+				t.Fatalf("span ended %d times, want 1", s.ended)
+			}
+
+			// the span context reaches the request and the server
+			wantURL := mock.URL() + "/" + common.APIVersion + "/" + common.EndpointNodeDelete
+			if rec.method != http.MethodPost || rec.url != wantURL || rec.span != s.name {
+				t.Fatalf("request = %s %s in span %q, want POST %s in span %q", rec.method, rec.url, rec.span, wantURL, s.name)
+			}
+			if traceparent != s.name {
+				t.Fatalf("Traceparent = %q, want %q", traceparent, s.name)
+			}
+
+			if tt.wantStatus == 0 {
+				if s.err != nil {
+					t.Fatalf("span error = %v, want nil", s.err)
+				}
+				return
+			}
+			var apiErr *errors.AnedyaError
+			if !stderrors.As(s.err, &apiErr) || apiErr.StatusCode != tt.wantStatus {
+				t.Fatalf("span error = %v, want an AnedyaError with status %d", s.err, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestWithTracerTransportError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	url := mock.URL()
+	mock.Close()
+
+	var tracer recordingTracer
+	client := anedya.NewClient(url, "token", anedya.WithTracer(&tracer))
+	defer client.Close()
+
+	err := client.NodeManagement.DeleteNode(context.Background(), &nodes.DeleteNodeRequest{NodeID: "n1"})
+	if err == nil {
+		t.Fatal("DeleteNode() error = nil, want a connection error")
+	}
+	if len(tracer.spans) != 1 || tracer.spans[0].ended != 1 || tracer.spans[0].err == nil {
+		t.Fatalf("spans = %+v, want one span ended once with an error", tracer.spans)
+	}
+}
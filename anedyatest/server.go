@@ -0,0 +1,231 @@
+// Package anedyatest provides an in-process mock of the Anedya API for
+// testing code that uses the Anedya Go SDK.
+//
+// A MockServer answers every endpoint known to the SDK with a canned
+// successful response. Tests override individual endpoints with the
+// typed On* methods or with Handle:
+//
+//	mock := anedyatest.NewServer()
+//	defer mock.Close()
+//
+//	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+//		return map[string]nodes.Node{"n1": {NodeId: "n1", NodeName: "pump"}}, nil
+//	})
+//
+//	client := mock.Client()
+//	details, err := client.NodeManagement.GetNodeDetails(ctx, &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+package anedyatest
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// MockServer is an httptest server that mimics the Anedya API.
+//
+// It is safe for concurrent use. Requests to paths that are neither
+// known endpoints nor registered with Handle receive a 404 response.
+type MockServer struct {
+	// Server is the underlying test server.
+	Server *httptest.Server
+
+	mu       sync.RWMutex
+	handlers map[string]http.HandlerFunc
+}
+
+// NewServer starts a MockServer with canned handlers for every
+// endpoint known to the SDK. Callers must Close it when done.
+func NewServer() *MockServer {
+	m := &MockServer{
+		handlers: make(map[string]http.HandlerFunc),
+	}
+
+	// answer every endpoint known to the SDK until a test overrides it
+	for _, endpoint := range common.Endpoints() {
+		m.handlers[endpointPath(endpoint)] = cannedSuccess
+	}
+
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	return m
+}
+
+// URL returns the base URL of the mock server.
+func (m *MockServer) URL() string {
+	return m.Server.URL
+}
+
+// Client returns an *anedya.Client pointed at the mock server.
+//
+// A fixed test API key is used; opts are passed on to anedya.NewClient.
+func (m *MockServer) Client(opts ...anedya.Option) *anedya.Client {
+	return anedya.NewClient(m.Server.URL, "anedyatest-api-key", opts...)
+}
+
+// Close shuts down the mock server.
+func (m *MockServer) Close() {
+	m.Server.Close()
+}
+
+// Handle registers h for path, replacing any existing handler.
+//
+// Use it for endpoints without a typed On* method, or to control the
+// raw HTTP response.
+func (m *MockServer) Handle(path string, h http.HandlerFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[path] = h
+}
+
+// OnNodeDetails stubs the Get Node Details endpoint.
+func (m *MockServer) OnNodeDetails(fn func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error)) {
+	m.Handle(endpointPath(common.EndpointNodeDetails), func(w http.ResponseWriter, r *http.Request) {
+		var req nodes.GetNodeDetailsRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		data, err := fn(req)
+		writeResult(w, err, &nodes.GetNodeDetailsResponse{Success: true, Data: data})
+	})
+}
+
+// OnNodeList stubs the Get Node List endpoint. The returned node IDs
+// are reported as a single page with matching counts.
+func (m *MockServer) OnNodeList(fn func(req nodes.GetNodeListRequest) ([]string, error)) {
+	m.Handle(endpointPath(common.EndpointNodeList), func(w http.ResponseWriter, r *http.Request) {
+		var req nodes.GetNodeListRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		ids, err := fn(req)
+		writeResult(w, err, &nodes.GetNodeListResponse{
+			Success:      true,
+			CurrentCount: len(ids),
+			TotalCount:   req.Offset + len(ids),
+			Nodes:        ids,
+			Offset:       req.Offset,
+		})
+	})
+}
+
+// OnGetData stubs the Get Data endpoint.
+func (m *MockServer) OnGetData(fn func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error)) {
+	m.Handle(endpointPath(common.EndpointDataGetData), func(w http.ResponseWriter, r *http.Request) {
+		var req dataAccess.GetDataRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		data, err := fn(req)
+
+		count := 0
+		for _, points := range data {
+			count += len(points)
+		}
+		writeResult(w, err, &dataAccess.GetDataResponse{
+			Success:  true,
+			Variable: req.Variable,
+			Count:    count,
+			Data:     data,
+		})
+	})
+}
+
+// OnLatestData stubs the Get Latest Data endpoint.
+func (m *MockServer) OnLatestData(fn func(req dataAccess.GetLatestDataRequest) (map[string]dataAccess.DataPoint, error)) {
+	m.Handle(endpointPath(common.EndpointDataLatest), func(w http.ResponseWriter, r *http.Request) {
+		var req dataAccess.GetLatestDataRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		data, err := fn(req)
+		writeResult(w, err, &dataAccess.GetLatestDataResponse{
+			Success: true,
+			Data:    data,
+			Count:   len(data),
+		})
+	})
+}
+
+func (m *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.RLock()
+	h, ok := m.handlers[r.URL.Path]
+	m.mu.RUnlock()
+
+	if !ok {
+		writeJSON(w, http.StatusNotFound, apiError{Error: "endpoint not found"})
+		return
+	}
+	h(w, r)
+}
+
+// endpointPath returns the request path the SDK uses for endpoint.
+func endpointPath(endpoint string) string {
+	return "/" + common.APIVersion + "/" + endpoint
+}
+
+// apiError is the error body returned by the Anedya API.
+type apiError struct {
+	Success    bool   `json:"success"`
+	Error      string `json:"error"`
+	ReasonCode string `json:"reasonCode,omitempty"`
+}
+
+// cannedSuccess answers with a bare successful response.
+func cannedSuccess(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+// decodeRequest decodes the JSON request body into v, answering with
+// a malformed-request error when it cannot be decoded.
+func decodeRequest(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeJSON(w, http.StatusBadRequest, apiError{
+			Error:      "malformed request: " + err.Error(),
+			ReasonCode: string(errors.ReasonMalformedRequest),
+		})
+		return false
+	}
+	return true
+}
+
+// writeResult writes resp on success, or an API error derived from
+// err otherwise.
+//
+// An *errors.AnedyaError returned by a stub controls the reason code
+// and HTTP status of the error response, so the SDK maps it back to
+// the same sentinel error. Other errors become a 400 response without
+// a reason code.
+func writeResult(w http.ResponseWriter, err error, resp interface{}) {
+	if err == nil {
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+
+	status := http.StatusBadRequest
+	body := apiError{Error: err.Error()}
+
+	var apiErr *errors.AnedyaError
+	if stderrors.As(err, &apiErr) {
+		body.Error = apiErr.Message
+		body.ReasonCode = string(apiErr.ReasonCode)
+		if apiErr.StatusCode != 0 {
+			status = apiErr.StatusCode
+		}
+	}
+
+	writeJSON(w, status, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
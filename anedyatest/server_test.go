@@ -0,0 +1,275 @@
+package anedyatest_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestCannedResponses(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	for _, endpoint := range common.Endpoints() {
+		t.Run(endpoint, func(t *testing.T) {
+			resp, err := http.Post(mock.URL()+"/"+common.APIVersion+"/"+endpoint, "application/json", strings.NewReader(`{}`))
+			if err != nil {
+				t.Fatalf("POST %s: %v", endpoint, err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			var body struct {
+				Success bool `json:"success"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if !body.Success {
+				t.Fatalf("success = false, want true")
+			}
+		})
+	}
+}
+
+func TestUnknownEndpoint(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "unknown endpoint", path: "/v1/does/not/exist"},
+		{name: "known endpoint without version", path: "/" + common.EndpointNodeList},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(mock.URL()+tt.path, "application/json", strings.NewReader(`{}`))
+			if err != nil {
+				t.Fatalf("POST %s: %v", tt.path, err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusNotFound {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+			}
+			var body struct {
+				Success bool   `json:"success"`
+				Error   string `json:"error"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body.Success || body.Error == "" {
+				t.Fatalf("body = %+v, want an unsuccessful response with an error message", body)
+			}
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.Handle("/custom", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	resp, err := http.Get(mock.URL() + "/custom")
+	if err != nil {
+		t.Fatalf("GET /custom: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTeapot {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusTeapot)
+	}
+}
+
+func TestOnNodeDetails(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]nodes.Node
+		stubErr  error
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:     "returns stubbed nodes",
+			data:     map[string]nodes.Node{"n1": {NodeId: "n1", NodeName: "pump"}},
+			wantName: "pump",
+		},
+		{
+			name: "maps reason code back to sentinel",
+			stubErr: &errors.AnedyaError{
+				Message:    "node not found",
+				ReasonCode: errors.ReasonNodeNotFound,
+				StatusCode: http.StatusNotFound,
+			},
+			wantErr: errors.ErrNodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var got nodes.GetNodeDetailsRequest
+			mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+				got = req
+				return tt.data, tt.stubErr
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			resp, err := client.NodeManagement.GetNodeDetails(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+
+			if len(got.Nodes) != 1 || got.Nodes[0] != "n1" {
+				t.Fatalf("stub received nodes %v, want [n1]", got.Nodes)
+			}
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("GetNodeDetails() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetNodeDetails() error = %v", err)
+			}
+			if name := resp["n1"].NodeName; name != tt.wantName {
+				t.Fatalf("NodeName = %q, want %q", name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestOnNodeList(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnNodeList(func(req nodes.GetNodeListRequest) ([]string, error) {
+		if req.Offset > 0 {
+			return nil, nil
+		}
+		return []string{"n1", "n2"}, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	resp, err := client.NodeManagement.GetNodeList(context.Background(), &nodes.GetNodeListRequest{Limit: 10, Order: "asc"})
+	if err != nil {
+		t.Fatalf("GetNodeList() error = %v", err)
+	}
+	if resp.CurrentCount != 2 || resp.TotalCount != 2 || len(resp.Nodes) != 2 {
+		t.Fatalf("GetNodeList() = %+v, want two nodes with matching counts", resp)
+	}
+}
+
+func TestOnGetData(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+		return map[string][]dataAccess.DataPoint{
+			"n1": {
+				{Timestamp: req.From, Value: json.RawMessage(`1`)},
+				{Timestamp: req.To, Value: json.RawMessage(`2`)},
+			},
+		}, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	resp, err := client.DataManagement.GetData(context.Background(), &dataAccess.GetDataRequest{
+		Variable: "temperature",
+		Nodes:    []string{"n1"},
+		From:     1000,
+		To:       2000,
+	})
+	if err != nil {
+		t.Fatalf("GetData() error = %v", err)
+	}
+	if resp.Variable != "temperature" || resp.Count != 2 {
+		t.Fatalf("GetData() = %+v, want variable temperature with 2 points", resp)
+	}
+	if points := resp.Data["n1"]; len(points) != 2 || points[0].Timestamp != 1000 || points[1].Timestamp != 2000 {
+		t.Fatalf("Data[n1] = %+v, want points at 1000 and 2000", points)
+	}
+}
+
+func TestOnLatestData(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnLatestData(func(req dataAccess.GetLatestDataRequest) (map[string]dataAccess.DataPoint, error) {
+		data := make(map[string]dataAccess.DataPoint, len(req.Nodes))
+		for _, id := range req.Nodes {
+			data[id] = dataAccess.DataPoint{Timestamp: 42, Value: json.RawMessage(`"on"`)}
+		}
+		return data, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	resp, err := client.DataManagement.GetLatestData(context.Background(), &dataAccess.GetLatestDataRequest{
+		Nodes:    []string{"n1", "n2"},
+		Variable: "state",
+	})
+	if err != nil {
+		t.Fatalf("GetLatestData() error = %v", err)
+	}
+	if resp.Count != 2 || resp.Data["n2"].Timestamp != 42 {
+		t.Fatalf("GetLatestData() = %+v, want two points at timestamp 42", resp)
+	}
+}
+
+func TestMalformedRequest(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	called := false
+	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+		called = true
+		return nil, nil
+	})
+
+	resp, err := http.Post(mock.URL()+"/"+common.APIVersion+"/"+common.EndpointNodeDetails, "application/json", strings.NewReader(`{`))
+	if err != nil {
+		t.Fatalf("POST: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if called {
+		t.Fatal("stub called for a malformed request")
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+	var body struct {
+		ReasonCode string `json:"reasonCode"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if body.ReasonCode != string(errors.ReasonMalformedRequest) {
+		t.Fatalf("reasonCode = %q, want %q", body.ReasonCode, errors.ReasonMalformedRequest)
+	}
+}
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"context"
+	"net/http"
+)
+
+// CaptureFunc receives the request path and raw body of an API
+// response that was decoded successfully.
+type CaptureFunc func(endpoint string, body []byte)
+
+// captureKey is the context key holding the CaptureFunc of a request.
+type captureKey struct{}
+
+// WithCapture returns a copy of ctx carrying fn.
+//
+// The client transport attaches it to the request of every successful
+// response when raw response capture is enabled.
+func WithCapture(ctx context.Context, fn CaptureFunc) context.Context {
+	return context.WithValue(ctx, captureKey{}, fn)
+}
+
+// CaptureDecoded passes body to the CaptureFunc attached to the request
+// that produced resp, if any.
+//
+// Manager methods call it once the response body has been decoded
+// without error and has passed the HTTP status and API success
+// checks; it does nothing when capture is disabled.
+func CaptureDecoded(resp *http.Response, body []byte) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+	if fn, ok := resp.Request.Context().Value(captureKey{}).(CaptureFunc); ok && fn != nil {
+		fn(resp.Request.URL.Path, body)
+	}
+}
@@ -0,0 +1,93 @@
+package common
+
+import "sort"
+
+// APIVersion is the version segment of every API endpoint URL.
+const APIVersion = "v1"
+
+// API endpoint paths, relative to the versioned API root.
+//
+// Managers build request URLs from these constants. The client tracer
+// and the anedyatest mock derive their endpoint tables from
+// EndpointOperation and Endpoints, so a new endpoint only needs to be
+// declared here.
+const (
+	EndpointNodeCreate           = "node/create"
+	EndpointNodeList             = "node/list"
+	EndpointNodeDetails          = "node/details"
+	EndpointNodeUpdate           = "node/update"
+	EndpointNodeDelete           = "node/delete"
+	EndpointNodeAuthorize        = "node/authorize"
+	EndpointNodeDeauthorize      = "node/deauthorize"
+	EndpointNodeGetConnectionKey = "node/getConnectionKey"
+	EndpointNodeChildAdd         = "node/child/add"
+	EndpointNodeChildRemove      = "node/child/remove"
+	EndpointNodeChildList        = "node/child/list"
+	EndpointNodeChildClear       = "node/child/clear"
+
+	EndpointVariableCreate = "variables/create"
+	EndpointVariableList   = "variables/list"
+	EndpointVariableDelete = "variables/delete"
+	EndpointVariableUpdate = "variables/update"
+
+	EndpointDataGetData  = "data/getData"
+	EndpointDataLatest   = "data/latest"
+	EndpointDataSnapshot = "data/snapshot"
+	EndpointDataSubmit   = "data/submitData"
+
+	// EndpointTokenDetails follows the naming of the create and revoke
+	// endpoints. It is not listed in the published API reference.
+	EndpointTokenCreate  = "access/tokens/create"
+	EndpointTokenRevoke  = "access/tokens/revoke"
+	EndpointTokenDetails = "access/tokens/details"
+)
+
+// endpointOperations maps every endpoint to the SDK operation that
+// calls it.
+var endpointOperations = map[string]string{
+	EndpointNodeCreate:           "nodes.CreateNode",
+	EndpointNodeList:             "nodes.GetNodeList",
+	EndpointNodeDetails:          "nodes.GetNodeDetails",
+	EndpointNodeUpdate:           "nodes.UpdateNode",
+	EndpointNodeDelete:           "nodes.DeleteNode",
+	EndpointNodeAuthorize:        "nodes.AuthorizeDevice",
+	EndpointNodeDeauthorize:      "nodes.DeauthorizeDevice",
+	EndpointNodeGetConnectionKey: "nodes.GetConnectionKey",
+	EndpointNodeChildAdd:         "nodes.AddChildNode",
+	EndpointNodeChildRemove:      "nodes.RemoveChildNode",
+	EndpointNodeChildList:        "nodes.ListChildNodes",
+	EndpointNodeChildClear:       "nodes.ClearChildNodes",
+
+	EndpointVariableCreate: "variable.CreateVariable",
+	EndpointVariableList:   "variable.ListAllVariable",
+	EndpointVariableDelete: "variable.DeleteVariable",
+	EndpointVariableUpdate: "variable.UpdateVariable",
+
+	EndpointDataGetData:  "dataAccess.GetData",
+	EndpointDataLatest:   "dataAccess.GetLatestData",
+	EndpointDataSnapshot: "dataAccess.GetSnapshot",
+	EndpointDataSubmit:   "dataAccess.SubmitData",
+
+	EndpointTokenCreate:  "accessTokens.CreateNewAccessToken",
+	EndpointTokenRevoke:  "accessTokens.RevokeAccessToken",
+	EndpointTokenDetails: "accessTokens.GetTokenDetails",
+}
+
+// EndpointOperation returns the SDK operation that calls endpoint,
+// such as "nodes.GetNodeList" for "node/list", and whether the
+// endpoint is known.
+func EndpointOperation(endpoint string) (string, bool) {
+	op, ok := endpointOperations[endpoint]
+	return op, ok
+}
+
+// Endpoints returns the paths of every API endpoint used by the SDK,
+// relative to the versioned API root, in sorted order.
+func Endpoints() []string {
+	endpoints := make([]string, 0, len(endpointOperations))
+	for endpoint := range endpointOperations {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+// MaxConcurrentRequests bounds the number of API requests issued
+// in parallel by helpers that fan out over several calls.
+const MaxConcurrentRequests = 4
+
+// FanOut runs call for every index in [0, n), with at most
+// MaxConcurrentRequests calls in flight at a time.
+//
+// The results of successful calls are passed to merge, which is never
+// called concurrently, so it may write to shared state without extra
+// locking. The first failing call cancels the context passed to the
+// calls still outstanding; calls that have not started yet are
+// skipped. FanOut waits for every started call and returns the first
+// error, or nil when all calls succeed.
+func FanOut[T any](
+	ctx context.Context,
+	n int,
+	call func(ctx context.Context, i int) (T, error),
+	merge func(i int, v T),
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		sem      = make(chan struct{}, MaxConcurrentRequests)
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var (
+				v   T
+				err error
+			)
+
+			select {
+			case sem <- struct{}{}:
+				v, err = call(ctx, i)
+				<-sem
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			merge(i, v)
+		}()
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,15 @@
+package common
+
+import "net/http"
+
+// SetJSONHeaders marks req as carrying and accepting JSON by setting
+// the Content-Type and Accept headers to application/json.
+//
+// Every Anedya API request sends and receives JSON, so all manager
+// methods call it after building their request. It is the only place
+// these headers are set: managers work with any *http.Client, so the
+// client transport does not add them.
+func SetJSONHeaders(req *http.Request) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+}
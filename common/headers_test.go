@@ -0,0 +1,24 @@
+package common_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+)
+
+func TestSetJSONHeaders(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://api.example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	common.SetJSONHeaders(req)
+
+	for _, name := range []string{"Content-Type", "Accept"} {
+		if got := req.Header.Values(name); len(got) != 1 || got[0] != "application/json" {
+			t.Fatalf("%s = %v, want [application/json]", name, got)
+		}
+	}
+}
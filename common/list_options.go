@@ -0,0 +1,65 @@
+// Package common provides helpers shared by the Anedya SDK
+// management packages.
+package common
+
+// ListOptions holds the pagination and sorting parameters
+// used by list operations.
+//
+// Each list method documents which of the fields it honours and the
+// defaults applied to fields left at their zero value.
+type ListOptions struct {
+	// Limit is the maximum number of items to return in a single request.
+	Limit int
+
+	// Offset is the number of items to skip before returning results.
+	Offset int
+
+	// Order is the sorting order, either "asc" or "desc".
+	Order string
+
+	// OrderBy is the field the results are sorted by.
+	OrderBy string
+}
+
+// ListOption configures a list operation.
+type ListOption func(*ListOptions)
+
+// WithLimit sets the maximum number of items returned per request.
+func WithLimit(limit int) ListOption {
+	return func(o *ListOptions) {
+		o.Limit = limit
+	}
+}
+
+// WithOffset sets the number of items to skip.
+func WithOffset(offset int) ListOption {
+	return func(o *ListOptions) {
+		o.Offset = offset
+	}
+}
+
+// WithOrder sets the sorting order, either "asc" or "desc".
+func WithOrder(order string) ListOption {
+	return func(o *ListOptions) {
+		o.Order = order
+	}
+}
+
+// WithOrderBy sets the field the results are sorted by.
+func WithOrderBy(field string) ListOption {
+	return func(o *ListOptions) {
+		o.OrderBy = field
+	}
+}
+
+// ApplyListOptions returns the ListOptions produced by applying
+// opts in order. Nil options are ignored.
+func ApplyListOptions(opts ...ListOption) ListOptions {
+	var o ListOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
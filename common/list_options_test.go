@@ -0,0 +1,48 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+)
+
+func TestApplyListOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []common.ListOption
+		want common.ListOptions
+	}{
+		{
+			name: "no options",
+			want: common.ListOptions{},
+		},
+		{
+			name: "every option",
+			opts: []common.ListOption{
+				common.WithLimit(50),
+				common.WithOffset(100),
+				common.WithOrder("desc"),
+				common.WithOrderBy("createdAt"),
+			},
+			want: common.ListOptions{Limit: 50, Offset: 100, Order: "desc", OrderBy: "createdAt"},
+		},
+		{
+			name: "later options win",
+			opts: []common.ListOption{common.WithLimit(10), common.WithLimit(20)},
+			want: common.ListOptions{Limit: 20},
+		},
+		{
+			name: "nil options are ignored",
+			opts: []common.ListOption{nil, common.WithOffset(5), nil},
+			want: common.ListOptions{Offset: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := common.ApplyListOptions(tt.opts...); got != tt.want {
+				t.Fatalf("ApplyListOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
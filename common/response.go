@@ -0,0 +1,20 @@
+package common
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// IsEmptySuccess reports whether resp is a 2xx response whose body is
+// empty or whitespace only.
+//
+// Some endpoints acknowledge operations that return no data with an
+// empty body instead of a JSON success object. Methods that return
+// only an error call it before decoding, so such responses count as
+// success rather than failing with errors.ErrResponseDecodeFailed.
+func IsEmptySuccess(resp *http.Response, body []byte) bool {
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return false
+	}
+	return len(bytes.TrimSpace(body)) == 0
+}
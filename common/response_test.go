@@ -0,0 +1,34 @@
+package common_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+)
+
+func TestIsEmptySuccess(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{name: "200 empty", status: http.StatusOK, body: "", want: true},
+		{name: "204 empty", status: http.StatusNoContent, body: "", want: true},
+		{name: "200 whitespace", status: http.StatusOK, body: " \r\n\t", want: true},
+		{name: "200 JSON", status: http.StatusOK, body: `{"success":true}`, want: false},
+		{name: "500 empty", status: http.StatusInternalServerError, body: "", want: false},
+		{name: "302 empty", status: http.StatusFound, body: "", want: false},
+		{name: "100 empty", status: http.StatusContinue, body: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status}
+			if got := common.IsEmptySuccess(resp, []byte(tt.body)); got != tt.want {
+				t.Fatalf("IsEmptySuccess() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
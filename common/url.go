@@ -0,0 +1,53 @@
+package common
+
+import (
+	"net/url"
+	"strings"
+)
+
+// JoinURL joins base and the given path segments into a single URL.
+//
+// It follows url.JoinPath semantics: duplicate and trailing slashes
+// between base and segments are collapsed, so a base URL with or
+// without a trailing slash yields the same result.
+//
+//	endpoint, err := common.JoinURL("https://api.ap-in-1.anedya.io/", common.APIVersion, common.EndpointNodeList)
+//	// https://api.ap-in-1.anedya.io/v1/node/list
+//
+// When the first segment is an API version such as "v1" and base
+// already ends with that same version, the version is not repeated, so
+// "https://api.ap-in-1.anedya.io/v1" also yields the URL above.
+//
+// An error is returned when base cannot be parsed as a URL.
+func JoinURL(base string, segments ...string) (string, error) {
+	if len(segments) > 0 {
+		u, err := url.Parse(base)
+		if err != nil {
+			return "", err
+		}
+
+		version := strings.Trim(segments[0], "/")
+		basePath := strings.TrimRight(u.Path, "/")
+		if isVersionSegment(version) && strings.HasSuffix(basePath, "/"+version) {
+			u.Path = strings.TrimSuffix(basePath, "/"+version)
+			u.RawPath = ""
+			base = u.String()
+		}
+	}
+
+	return url.JoinPath(base, segments...)
+}
+
+// isVersionSegment reports whether s is an API version path segment,
+// a "v" followed by one or more digits.
+func isVersionSegment(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, c := range s[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestJoinURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		segments []string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "plain",
+			base:     "https://api.example.com",
+			segments: []string{"v1", "node/list"},
+			want:     "https://api.example.com/v1/node/list",
+		},
+		{
+			name:     "trailing slash on base",
+			base:     "https://api.example.com/",
+			segments: []string{"v1", "node/list"},
+			want:     "https://api.example.com/v1/node/list",
+		},
+		{
+			name:     "repeated trailing slashes on base",
+			base:     "https://api.example.com//",
+			segments: []string{"v1", "node/list"},
+			want:     "https://api.example.com/v1/node/list",
+		},
+		{
+			name:     "leading slashes on segments",
+			base:     "https://api.example.com",
+			segments: []string{"/v1", "/node/list"},
+			want:     "https://api.example.com/v1/node/list",
+		},
+		{
+			name:     "slashes on both sides",
+			base:     "https://api.example.com/",
+			segments: []string{"/v1/", "/node/list"},
+			want:     "https://api.example.com/v1/node/list",
+		},
+		{
+			name:     "base with path",
+			base:     "https://proxy.example.com/anedya/",
+			segments: []string{"v1", "data/latest"},
+			want:     "https://proxy.example.com/anedya/v1/data/latest",
+		},
+		{
+			name:     "base ends with the version",
+			base:     "https://api.example.com/v1",
+			segments: []string{"v1", "node/list"},
+			want:     "https://api.example.com/v1/node/list",
+		},
+		{
+			name:     "base ends with the version and a slash",
+			base:     "https://proxy.example.com/anedya/v1/",
+			segments: []string{"v1", "node/list"},
+			want:     "https://proxy.example.com/anedya/v1/node/list",
+		},
+		{
+			name:     "base ends with another version",
+			base:     "https://api.example.com/v2",
+			segments: []string{"v1", "node/list"},
+			want:     "https://api.example.com/v2/v1/node/list",
+		},
+		{
+			name:     "base ends with a non-version segment",
+			base:     "https://api.example.com/dev",
+			segments: []string{"dev", "node/list"},
+			want:     "https://api.example.com/dev/dev/node/list",
+		},
+		{
+			name:     "version only in the middle of base",
+			base:     "https://api.example.com/v1/proxy",
+			segments: []string{"v1", "node/list"},
+			want:     "https://api.example.com/v1/proxy/v1/node/list",
+		},
+		{
+			name:     "invalid base",
+			base:     "://missing-scheme",
+			segments: []string{"v1"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := common.JoinURL(tt.base, tt.segments...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("JoinURL() = %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("JoinURL() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("JoinURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinURLClientBase(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnNodeList(func(req nodes.GetNodeListRequest) ([]string, error) {
+		return []string{"n1"}, nil
+	})
+
+	// the mock answers unknown paths, such as "//v1/node/list", with a 404
+	tests := []struct {
+		name string
+		base string
+	}{
+		{name: "no trailing slash", base: mock.URL()},
+		{name: "trailing slash", base: mock.URL() + "/"},
+		{name: "repeated trailing slashes", base: mock.URL() + "//"},
+		{name: "base includes the API version", base: mock.URL() + "/" + common.APIVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := anedya.NewClient(tt.base, "token")
+			defer client.Close()
+
+			if _, err := client.NodeManagement.ListNodes(context.Background()); err != nil {
+				t.Fatalf("ListNodes() with base %q error = %v", tt.base, err)
+			}
+		})
+	}
+}
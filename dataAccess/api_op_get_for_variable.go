@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -59,6 +61,30 @@ type GetDataResponse struct {
 	Data map[string][]DataPoint `json:"data"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// The data of each node is decoded as DataPoints, so a single point
+// object, null or an empty object are accepted as well as an array.
+func (r *GetDataResponse) UnmarshalJSON(b []byte) error {
+	type fields GetDataResponse
+	aux := struct {
+		*fields
+		Data map[string]DataPoints `json:"data"`
+	}{fields: (*fields)(r)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	if aux.Data != nil {
+		r.Data = make(map[string][]DataPoint, len(aux.Data))
+		for node, points := range aux.Data {
+			r.Data[node] = points
+		}
+	}
+	return nil
+}
+
 // GetData retrieves time-series data for a variable across one or more nodes
 // from the Anedya platform.
 //
@@ -81,10 +107,25 @@ func (dm *DataManagement) GetData(
 	ctx context.Context,
 	req *GetDataRequest,
 ) (*GetDataResponse, error) {
+	result, _, err := dm.GetDataRaw(ctx, req)
+	return result, err
+}
+
+// GetDataRaw behaves like GetData but also returns the underlying
+// *http.Response, so callers can inspect the status code and headers
+// such as rate-limit counters or request IDs.
+//
+// The response body has already been consumed and closed and must
+// not be read. The response is nil when the request never reached
+// the server, and is returned alongside the error otherwise.
+func (dm *DataManagement) GetDataRaw(
+	ctx context.Context,
+	req *GetDataRequest,
+) (*GetDataResponse, *http.Response, error) {
 
 	// check if request is nil
 	if req == nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "get data request cannot be nil",
 			Err:     errors.ErrRequestNil,
 		}
@@ -92,43 +133,44 @@ func (dm *DataManagement) GetData(
 
 	// variable name must be provided
 	if req.Variable == "" {
-		return nil, &errors.AnedyaError{
-			Message: "variable is required",
-			Err:     errors.ErrVariableRequired,
-		}
+		return nil, nil, errors.NewFieldError("variable", req.Variable, "is required", errors.ErrVariableRequired)
 	}
 
 	// at least one node must be provided
 	if len(req.Nodes) == 0 {
-		return nil, &errors.AnedyaError{
-			Message: "at least one node must be provided",
-			Err:     errors.ErrNodesEmpty,
+		return nil, nil, errors.NewFieldError("nodes", req.Nodes, "must contain at least one node", errors.ErrNodesEmpty)
+	}
+
+	// validate each node ID
+	for i, node := range req.Nodes {
+		if node == "" {
+			return nil, nil, errors.NewFieldError(fmt.Sprintf("nodes[%d]", i), node, "must not be empty", errors.ErrInvalidNode)
 		}
 	}
 
 	// validate timestamp range
-	if req.From <= 0 || req.To <= 0 || req.From > req.To {
-		return nil, &errors.AnedyaError{
-			Message: "invalid from/to timestamp range",
-			Err:     errors.ErrInvalidTimeRange,
-		}
+	if err := validateTimeRange(req.From, req.To); err != nil {
+		return nil, nil, err
 	}
 
 	// validate order field
 	if req.Order != "" && req.Order != "asc" && req.Order != "desc" {
-		return nil, &errors.AnedyaError{
-			Message: "order must be asc or desc",
-			Err:     errors.ErrInvalidOrder,
-		}
+		return nil, nil, errors.NewFieldError("order", req.Order, "must be asc or desc", errors.ErrInvalidOrder)
 	}
 
 	// build API URL
-	url := fmt.Sprintf("%s/v1/data/getData", dm.baseURL)
+	url, err := common.JoinURL(dm.baseURL, common.APIVersion, common.EndpointDataGetData)
+	if err != nil {
+		return nil, nil, &errors.AnedyaError{
+			Message: "failed to build GetData request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// convert request to JSON
 	body, err := json.Marshal(req)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to encode GetData request",
 			Err:     errors.ErrRequestEncodeFailed,
 		}
@@ -142,36 +184,69 @@ func (dm *DataManagement) GetData(
 		bytes.NewBuffer(body),
 	)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to build GetData request",
 			Err:     errors.ErrRequestBuildFailed,
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// send HTTP request
 	resp, err := dm.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to execute GetData request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message: "failed to read GetData response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// The body has been consumed; hide it from callers of the Raw variant.
+	resp.Body = http.NoBody
+
 	// decode API response
 	var apiResp GetDataResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, &errors.AnedyaError{
-			Message: "failed to decode GetData response",
-			Err:     errors.ErrResponseDecodeFailed,
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message:    "failed to decode GetData response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// handle HTTP or API-level errors
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return &apiResp, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return &apiResp, resp, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// success
-	return &apiResp, nil
+	return &apiResp, resp, nil
+}
+
+// validateTimeRange checks that from and to are positive Unix
+// millisecond timestamps and that from is not after to.
+func validateTimeRange(from, to int64) error {
+	if from <= 0 {
+		return errors.NewFieldError("from", from, "must be greater than 0", errors.ErrInvalidTimeRange)
+	}
+	if to <= 0 {
+		return errors.NewFieldError("to", to, "must be greater than 0", errors.ErrInvalidTimeRange)
+	}
+	if from > to {
+		return errors.NewFieldError("from", from, fmt.Sprintf("must not be after to (%d)", to), errors.ErrInvalidTimeRange)
+	}
+	return nil
 }
@@ -0,0 +1,91 @@
+package dataAccess_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGetDataNodeValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		nodes     []string
+		wantErr   error
+		wantField string
+	}{
+		{name: "no nodes", nodes: nil, wantErr: errors.ErrNodesEmpty, wantField: "nodes"},
+		{name: "empty first node", nodes: []string{"", "b"}, wantErr: errors.ErrInvalidNode, wantField: "nodes[0]"},
+		{name: "empty later node", nodes: []string{"a", ""}, wantErr: errors.ErrInvalidNode, wantField: "nodes[1]"},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+		requests++
+		return nil, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.DataManagement.GetData(context.Background(), &dataAccess.GetDataRequest{
+				Variable: "temp",
+				Nodes:    tt.nodes,
+				From:     1,
+				To:       2,
+			})
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("GetData() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want none", requests)
+	}
+}
+
+func TestGetDataJSONHeaders(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var header http.Header
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointDataGetData, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	req := &dataAccess.GetDataRequest{Variable: "temp", Nodes: []string{"n1"}, From: 1, To: 2}
+	if _, err := client.DataManagement.GetData(context.Background(), req); err != nil {
+		t.Fatalf("GetData() error = %v", err)
+	}
+	if header == nil {
+		t.Fatal("no request reached the server")
+	}
+	for _, name := range []string{"Content-Type", "Accept"} {
+		if got := header.Values(name); len(got) != 1 || got[0] != "application/json" {
+			t.Fatalf("%s = %v, want [application/json]", name, got)
+		}
+	}
+}
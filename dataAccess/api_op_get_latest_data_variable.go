@@ -8,8 +8,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -65,10 +67,25 @@ func (dm *DataManagement) GetLatestData(
 	ctx context.Context,
 	req *GetLatestDataRequest,
 ) (*GetLatestDataResponse, error) {
+	result, _, err := dm.GetLatestDataRaw(ctx, req)
+	return result, err
+}
+
+// GetLatestDataRaw behaves like GetLatestData but also returns the underlying
+// *http.Response, so callers can inspect the status code and headers
+// such as rate-limit counters or request IDs.
+//
+// The response body has already been consumed and closed and must
+// not be read. The response is nil when the request never reached
+// the server, and is returned alongside the error otherwise.
+func (dm *DataManagement) GetLatestDataRaw(
+	ctx context.Context,
+	req *GetLatestDataRequest,
+) (*GetLatestDataResponse, *http.Response, error) {
 
 	// check if request is nil
 	if req == nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "get latest data request cannot be nil",
 			Err:     errors.ErrRequestNil,
 		}
@@ -76,37 +93,34 @@ func (dm *DataManagement) GetLatestData(
 
 	// variable name must be provided
 	if req.Variable == "" {
-		return nil, &errors.AnedyaError{
-			Message: "variable is required",
-			Err:     errors.ErrVariableRequired,
-		}
+		return nil, nil, errors.NewFieldError("variable", req.Variable, "is required", errors.ErrVariableRequired)
 	}
 
 	// at least one node must be provided
 	if len(req.Nodes) == 0 {
-		return nil, &errors.AnedyaError{
-			Message: "at least one node must be provided",
-			Err:     errors.ErrNodesEmpty,
-		}
+		return nil, nil, errors.NewFieldError("nodes", req.Nodes, "must contain at least one node", errors.ErrNodesEmpty)
 	}
 
 	// validate each node ID
 	for i, node := range req.Nodes {
 		if node == "" {
-			return nil, &errors.AnedyaError{
-				Message: fmt.Sprintf("node id at index %d is empty", i),
-				Err:     errors.ErrInvalidNode,
-			}
+			return nil, nil, errors.NewFieldError(fmt.Sprintf("nodes[%d]", i), node, "must not be empty", errors.ErrInvalidNode)
 		}
 	}
 
 	// build API URL
-	url := fmt.Sprintf("%s/v1/data/latest", dm.baseURL)
+	url, err := common.JoinURL(dm.baseURL, common.APIVersion, common.EndpointDataLatest)
+	if err != nil {
+		return nil, nil, &errors.AnedyaError{
+			Message: "failed to build GetLatestData request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// convert request to JSON
 	body, err := json.Marshal(req)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to encode GetLatestData request",
 			Err:     errors.ErrRequestEncodeFailed,
 		}
@@ -120,36 +134,54 @@ func (dm *DataManagement) GetLatestData(
 		bytes.NewBuffer(body),
 	)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to build GetLatestData request",
 			Err:     errors.ErrRequestBuildFailed,
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// send HTTP request
 	resp, err := dm.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to execute GetLatestData request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message: "failed to read GetLatestData response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// The body has been consumed; hide it from callers of the Raw variant.
+	resp.Body = http.NoBody
+
 	// decode API response
 	var apiResp GetLatestDataResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, &errors.AnedyaError{
-			Message: "failed to decode GetLatestData response",
-			Err:     errors.ErrResponseDecodeFailed,
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message:    "failed to decode GetLatestData response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// handle HTTP or API-level errors
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return &apiResp, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return &apiResp, resp, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// success
-	return &apiResp, nil
+	return &apiResp, resp, nil
 }
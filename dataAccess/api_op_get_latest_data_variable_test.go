@@ -0,0 +1,73 @@
+package dataAccess_test
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGetLatestDataValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       dataAccess.GetLatestDataRequest
+		wantErr   error
+		wantField string
+	}{
+		{
+			name:      "no variable",
+			req:       dataAccess.GetLatestDataRequest{Nodes: []string{"n1"}},
+			wantErr:   errors.ErrVariableRequired,
+			wantField: "variable",
+		},
+		{
+			name:      "no nodes",
+			req:       dataAccess.GetLatestDataRequest{Variable: "temp"},
+			wantErr:   errors.ErrNodesEmpty,
+			wantField: "nodes",
+		},
+		{
+			name:      "empty node",
+			req:       dataAccess.GetLatestDataRequest{Variable: "temp", Nodes: []string{"n1", ""}},
+			wantErr:   errors.ErrInvalidNode,
+			wantField: "nodes[1]",
+		},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.OnLatestData(func(req dataAccess.GetLatestDataRequest) (map[string]dataAccess.DataPoint, error) {
+		requests++
+		return nil, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			_, err := client.DataManagement.GetLatestData(context.Background(), &req)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("GetLatestData() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want 0", requests)
+	}
+}
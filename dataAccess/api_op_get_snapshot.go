@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -81,40 +83,34 @@ func (dm *DataManagement) GetSnapshot(
 
 	// variable name must be provided
 	if req.Variable == "" {
-		return nil, &errors.AnedyaError{
-			Message: "variable is required",
-			Err:     errors.ErrVariableRequired,
-		}
+		return nil, errors.NewFieldError("variable", req.Variable, "is required", errors.ErrVariableRequired)
 	}
 
 	// timestamp must be valid
 	if req.Timestamp <= 0 {
-		return nil, &errors.AnedyaError{
-			Message: "timestamp must be greater than 0",
-			Err:     errors.ErrInvalidTimestamp,
-		}
+		return nil, errors.NewFieldError("timestamp", req.Timestamp, "must be greater than 0", errors.ErrInvalidTimestamp)
 	}
 
 	// at least one node must be provided
 	if len(req.Nodes) == 0 {
-		return nil, &errors.AnedyaError{
-			Message: "at least one node must be provided",
-			Err:     errors.ErrNodesEmpty,
-		}
+		return nil, errors.NewFieldError("nodes", req.Nodes, "must contain at least one node", errors.ErrNodesEmpty)
 	}
 
 	// validate each node ID
 	for i, node := range req.Nodes {
 		if node == "" {
-			return nil, &errors.AnedyaError{
-				Message: fmt.Sprintf("node id at index %d is empty", i),
-				Err:     errors.ErrInvalidNode,
-			}
+			return nil, errors.NewFieldError(fmt.Sprintf("nodes[%d]", i), node, "must not be empty", errors.ErrInvalidNode)
 		}
 	}
 
 	// build API URL
-	url := fmt.Sprintf("%s/v1/data/snapshot", dm.baseURL)
+	url, err := common.JoinURL(dm.baseURL, common.APIVersion, common.EndpointDataSnapshot)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build GetSnapshot request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// convert request to JSON
 	body, err := json.Marshal(req)
@@ -139,30 +135,45 @@ func (dm *DataManagement) GetSnapshot(
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// send HTTP request
 	resp, err := dm.httpClient.Do(httpReq)
 	if err != nil {
 		return nil, &errors.AnedyaError{
 			Message: "failed to execute GetSnapshot request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to read GetSnapshot response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
 	// decode API response
 	var apiResp GetSnapshotResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return nil, &errors.AnedyaError{
-			Message: "failed to decode GetSnapshot response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode GetSnapshot response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// handle HTTP or API-level errors
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return &apiResp, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return &apiResp, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// success
 	return &apiResp, nil
 }
@@ -0,0 +1,87 @@
+package dataAccess_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGetSnapshotValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       dataAccess.GetSnapshotRequest
+		wantErr   error
+		wantField string
+	}{
+		{
+			name:      "no variable",
+			req:       dataAccess.GetSnapshotRequest{Timestamp: 1000, Nodes: []string{"n1"}},
+			wantErr:   errors.ErrVariableRequired,
+			wantField: "variable",
+		},
+		{
+			name:      "no timestamp",
+			req:       dataAccess.GetSnapshotRequest{Variable: "temp", Nodes: []string{"n1"}},
+			wantErr:   errors.ErrInvalidTimestamp,
+			wantField: "timestamp",
+		},
+		{
+			name:      "negative timestamp",
+			req:       dataAccess.GetSnapshotRequest{Variable: "temp", Timestamp: -1, Nodes: []string{"n1"}},
+			wantErr:   errors.ErrInvalidTimestamp,
+			wantField: "timestamp",
+		},
+		{
+			name:      "no nodes",
+			req:       dataAccess.GetSnapshotRequest{Variable: "temp", Timestamp: 1000},
+			wantErr:   errors.ErrNodesEmpty,
+			wantField: "nodes",
+		},
+		{
+			name:      "empty node",
+			req:       dataAccess.GetSnapshotRequest{Variable: "temp", Timestamp: 1000, Nodes: []string{"", "n2"}},
+			wantErr:   errors.ErrInvalidNode,
+			wantField: "nodes[0]",
+		},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointDataSnapshot, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			_, err := client.DataManagement.GetSnapshot(context.Background(), &req)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("GetSnapshot() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want 0", requests)
+	}
+}
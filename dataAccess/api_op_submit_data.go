@@ -0,0 +1,254 @@
+// Package dataAccess provides APIs to retrieve and manage
+// time-series data for nodes within the Anedya platform.
+package dataAccess
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// SubmitDataPoint represents a single value to be recorded
+// for a variable.
+type SubmitDataPoint struct {
+	// Timestamp is the time of the reading (Unix milliseconds).
+	//
+	// Leave it zero to let the platform stamp the reading with the
+	// time it is received. Within a single request either all points
+	// carry a timestamp or none do.
+	Timestamp int64 `json:"timestamp,omitempty"`
+
+	// Value is the value of the reading.
+	// It must be a number for float variables and a GeoValue
+	// for geo variables.
+	Value interface{} `json:"value"`
+}
+
+// SubmitDataRequest represents the payload used to submit
+// data points for a variable of a node.
+type SubmitDataRequest struct {
+	// NodeID is the node for which data is submitted.
+	NodeID string `json:"nodeId"`
+
+	// Variable is the name of the variable the data belongs to.
+	Variable string `json:"variable"`
+
+	// Type optionally declares the variable type ("float" or "geo").
+	// When set, every value is checked against it before sending.
+	// It is not sent to the API.
+	Type string `json:"-"`
+
+	// Data is the list of data points to submit.
+	Data []SubmitDataPoint `json:"data"`
+}
+
+// SubmitDataResponse represents the response returned by
+// the Submit Data API.
+type SubmitDataResponse struct {
+	// Success indicates whether the request was processed successfully.
+	Success bool `json:"success"`
+
+	// Error contains a human-readable error message when Success is false.
+	Error string `json:"error"`
+
+	// ReasonCode is the machine-readable error code
+	// used for SDK error mapping.
+	ReasonCode string `json:"reasonCode,omitempty"`
+}
+
+// SubmitData submits one or more data points for a variable of a node
+// to the Anedya platform.
+//
+// Steps performed by this method:
+//  1. Validate the request payload, timestamps and values.
+//     Points without a timestamp are stamped by the platform.
+//  2. Marshal the request into JSON format.
+//  3. Build and send a POST request to the Submit Data API.
+//  4. Decode the API response into SubmitDataResponse.
+//  5. Map API-level errors into structured SDK errors.
+//
+// Parameters:
+//   - ctx: Context used to control request lifecycle, cancellation, and deadlines.
+//   - req: Pointer to SubmitDataRequest containing the data points.
+//
+// Returns:
+//   - nil if the data is submitted successfully.
+//   - error for validation, client-side or API failures.
+func (dm *DataManagement) SubmitData(
+	ctx context.Context,
+	req *SubmitDataRequest,
+) error {
+
+	// check if request is nil
+	if req == nil {
+		return &errors.AnedyaError{
+			Message: "submit data request cannot be nil",
+			Err:     errors.ErrRequestNil,
+		}
+	}
+
+	// node id must be provided
+	if req.NodeID == "" {
+		return errors.NewFieldError("nodeId", req.NodeID, "is required", errors.ErrInvalidNode)
+	}
+
+	// variable name must be provided
+	if req.Variable == "" {
+		return errors.NewFieldError("variable", req.Variable, "is required", errors.ErrVariableRequired)
+	}
+
+	// at least one data point must be provided
+	if len(req.Data) == 0 {
+		return errors.NewFieldError("data", req.Data, "must contain at least one data point", errors.ErrInputRequired)
+	}
+
+	// timestamps must be either all client-assigned or all server-assigned
+	serverTime := req.Data[0].Timestamp == 0
+
+	// validate each data point
+	for i, p := range req.Data {
+		if p.Timestamp < 0 {
+			return errors.NewFieldError(fmt.Sprintf("data[%d].timestamp", i), p.Timestamp, "must not be negative", errors.ErrInvalidTimestamp)
+		}
+
+		if (p.Timestamp == 0) != serverTime {
+			return errors.NewFieldError(fmt.Sprintf("data[%d].timestamp", i), p.Timestamp, "mixes client and server timestamps", errors.ErrInvalidTimestamp)
+		}
+
+		if !valueMatchesType(p.Value, req.Type) {
+			return errors.NewFieldError(fmt.Sprintf("data[%d].value", i), p.Value, fmt.Sprintf("does not match variable type %q", req.Type), errors.ErrInvalidValueType)
+		}
+
+		if err := validateGeoValue(p.Value); err != nil {
+			return errors.NewFieldError(fmt.Sprintf("data[%d].value", i), p.Value, "is not a valid geo coordinate", errors.ErrInvalidGeoValue)
+		}
+	}
+
+	// build API URL
+	url, err := common.JoinURL(dm.baseURL, common.APIVersion, common.EndpointDataSubmit)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build SubmitData request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	// convert request to JSON
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to encode SubmitData request",
+			Err:     errors.ErrRequestEncodeFailed,
+		}
+	}
+
+	// create HTTP request with context
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		url,
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build SubmitData request",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	common.SetJSONHeaders(httpReq)
+
+	// send HTTP request
+	resp, err := dm.httpClient.Do(httpReq)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to execute SubmitData request",
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
+		}
+	}
+	defer resp.Body.Close()
+
+	// read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read SubmitData response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// an empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, respBody) {
+		return nil
+	}
+
+	// decode API response
+	var apiResp SubmitDataResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return &errors.AnedyaError{
+			Message:    "failed to decode SubmitData response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
+		}
+	}
+
+	// handle HTTP or API-level errors
+	if resp.StatusCode != http.StatusOK || !apiResp.Success {
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
+	}
+
+	common.CaptureDecoded(resp, respBody)
+
+	// success
+	return nil
+}
+
+// validateGeoValue checks the coordinate range of geo values and
+// rejects nil *GeoValue pointers, which would be sent as null.
+// Values of other types are not checked.
+func validateGeoValue(v interface{}) error {
+	switch g := v.(type) {
+	case GeoValue:
+		return g.Validate()
+	case *GeoValue:
+		if g == nil {
+			return &errors.AnedyaError{
+				Message: "geo value is nil",
+				Err:     errors.ErrInvalidGeoValue,
+			}
+		}
+		return g.Validate()
+	}
+	return nil
+}
+
+// valueMatchesType reports whether v is a valid value for a variable
+// of the given type. Unknown or empty types accept any value.
+func valueMatchesType(v interface{}, variableType string) bool {
+	switch variableType {
+	case "float":
+		switch v.(type) {
+		case float64, float32, int, int8, int16, int32, int64,
+			uint, uint8, uint16, uint32, uint64, json.Number:
+			return true
+		default:
+			return false
+		}
+	case "geo":
+		switch v.(type) {
+		case GeoValue, *GeoValue:
+			return true
+		default:
+			return false
+		}
+	default:
+		return v != nil
+	}
+}
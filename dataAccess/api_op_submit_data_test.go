@@ -0,0 +1,246 @@
+package dataAccess_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestSubmitDataTimestamps(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []dataAccess.SubmitDataPoint
+		wantT     []int64 // timestamps sent, nil when omitted
+		wantField string  // field rejected before sending, if any
+	}{
+		{
+			name: "client stamped",
+			data: []dataAccess.SubmitDataPoint{
+				{Timestamp: 1000, Value: 1.5},
+				{Timestamp: 2000, Value: 2.5},
+			},
+			wantT: []int64{1000, 2000},
+		},
+		{
+			name: "server stamped",
+			data: []dataAccess.SubmitDataPoint{
+				{Value: 1.5},
+				{Value: 2.5},
+			},
+		},
+		{
+			name: "mixed is rejected",
+			data: []dataAccess.SubmitDataPoint{
+				{Timestamp: 1000, Value: 1.5},
+				{Value: 2.5},
+			},
+			wantField: "data[1].timestamp",
+		},
+		{
+			name: "mixed starting server stamped is rejected",
+			data: []dataAccess.SubmitDataPoint{
+				{Value: 1.5},
+				{Timestamp: 2000, Value: 2.5},
+			},
+			wantField: "data[1].timestamp",
+		},
+		{
+			name: "negative is rejected",
+			data: []dataAccess.SubmitDataPoint{
+				{Timestamp: -1, Value: 1.5},
+			},
+			wantField: "data[0].timestamp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var sent []map[string]json.RawMessage
+			called := false
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointDataSubmit, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				var body struct {
+					Data []map[string]json.RawMessage `json:"data"`
+				}
+				json.NewDecoder(r.Body).Decode(&body)
+				sent = body.Data
+				w.Write([]byte(`{"success":true}`))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			err := client.DataManagement.SubmitData(context.Background(), &dataAccess.SubmitDataRequest{
+				NodeID:   "n1",
+				Variable: "temp",
+				Data:     tt.data,
+			})
+
+			if tt.wantField != "" {
+				var fe *errors.FieldError
+				if !stderrors.As(err, &fe) || fe.Field != tt.wantField {
+					t.Fatalf("SubmitData() error = %v, want a field error for %s", err, tt.wantField)
+				}
+				if !stderrors.Is(err, errors.ErrInvalidTimestamp) {
+					t.Fatalf("SubmitData() error = %v, want %v", err, errors.ErrInvalidTimestamp)
+				}
+				if called {
+					t.Fatal("invalid request was sent to the server")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SubmitData() error = %v", err)
+			}
+			if len(sent) != len(tt.data) {
+				t.Fatalf("sent %d points, want %d", len(sent), len(tt.data))
+			}
+			for i, p := range sent {
+				raw, ok := p["timestamp"]
+				if tt.wantT == nil {
+					if ok {
+						t.Fatalf("point %d sent timestamp %s, want it omitted", i, raw)
+					}
+					continue
+				}
+
+				var ts int64
+				if err := json.Unmarshal(raw, &ts); err != nil || ts != tt.wantT[i] {
+					t.Fatalf("point %d timestamp = %s, want %d", i, raw, tt.wantT[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitDataValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       dataAccess.SubmitDataRequest
+		wantErr   error
+		wantField string
+	}{
+		{
+			name:      "no node",
+			req:       dataAccess.SubmitDataRequest{Variable: "temp", Data: []dataAccess.SubmitDataPoint{{Value: 1.5}}},
+			wantErr:   errors.ErrInvalidNode,
+			wantField: "nodeId",
+		},
+		{
+			name:      "no variable",
+			req:       dataAccess.SubmitDataRequest{NodeID: "n1", Data: []dataAccess.SubmitDataPoint{{Value: 1.5}}},
+			wantErr:   errors.ErrVariableRequired,
+			wantField: "variable",
+		},
+		{
+			name:      "no data",
+			req:       dataAccess.SubmitDataRequest{NodeID: "n1", Variable: "temp"},
+			wantErr:   errors.ErrInputRequired,
+			wantField: "data",
+		},
+		{
+			name: "value does not match type",
+			req: dataAccess.SubmitDataRequest{NodeID: "n1", Variable: "temp", Type: "float", Data: []dataAccess.SubmitDataPoint{
+				{Value: 1.5},
+				{Value: "hot"},
+			}},
+			wantErr:   errors.ErrInvalidValueType,
+			wantField: "data[1].value",
+		},
+		{
+			name: "geo value out of range",
+			req: dataAccess.SubmitDataRequest{NodeID: "n1", Variable: "pos", Type: "geo", Data: []dataAccess.SubmitDataPoint{
+				{Value: dataAccess.GeoValue{Lat: 91, Long: 0}},
+			}},
+			wantErr:   errors.ErrInvalidGeoValue,
+			wantField: "data[0].value",
+		},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointDataSubmit, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			err := client.DataManagement.SubmitData(context.Background(), &req)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("SubmitData() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want 0", requests)
+	}
+}
+
+func TestSubmitDataEmptySuccessBodies(t *testing.T) {
+	bodies := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{name: "empty 200", status: http.StatusOK},
+		{name: "empty 204", status: http.StatusNoContent},
+		{name: "JSON success", status: http.StatusOK, body: `{"success":true}`},
+		{name: "empty error status", status: http.StatusInternalServerError, wantErr: errors.ErrResponseDecodeFailed},
+		{name: "JSON failure", status: http.StatusOK, body: `{"success":false,"error":"boom"}`, wantErr: errors.ErrUnknown},
+	}
+
+	for _, b := range bodies {
+		t.Run(b.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointDataSubmit, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(b.status)
+				w.Write([]byte(b.body))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			err := client.DataManagement.SubmitData(context.Background(), &dataAccess.SubmitDataRequest{
+				NodeID:   "n1",
+				Variable: "temp",
+				Data:     []dataAccess.SubmitDataPoint{{Value: 1.5}},
+			})
+			if b.wantErr == nil && err != nil {
+				t.Fatalf("SubmitData() error = %v, want nil", err)
+			}
+			if b.wantErr != nil && !stderrors.Is(err, b.wantErr) {
+				t.Fatalf("SubmitData() error = %v, want %v", err, b.wantErr)
+			}
+		})
+	}
+}
@@ -1,11 +1,18 @@
 package dataAccess
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
 
 // GeoValue represents a geographical coordinate.
 //
 // It contains latitude and longitude values and is typically
 // used when a variable stores location-based data.
+//
+// It encodes to the platform format {"lat": ..., "long": ...}.
+// Marshaling rejects coordinates outside the valid range; see Validate.
 type GeoValue struct {
 	Lat  float64 `json:"lat"`  // Latitude
 	Long float64 `json:"long"` // Longitude
@@ -18,9 +25,93 @@ type GeoValue struct {
 //   - numeric values (float, int)
 //   - structured objects (e.g., GeoValue)
 //
-// Helper methods like AsFloat and AsGeo can be used to
-// safely decode the value into the expected type.
+// Helper methods like AsFloat and AsGeo, or Float and Geo which
+// report errors, can be used to safely decode the value into the
+// expected type.
+//
+// When decoding, a point sent as a one-element array is accepted and an
+// empty array leaves the DataPoint unchanged. An array of several
+// points is an error, since picking one would silently drop data.
 type DataPoint struct {
 	Timestamp int64           `json:"timestamp"` // Unix timestamp in milliseconds
 	Value     json.RawMessage `json:"value"`     // Raw JSON-encoded value
 }
+
+// dataPointFields has the fields of DataPoint without its
+// UnmarshalJSON method, so it can be decoded directly.
+type dataPointFields DataPoint
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (dp *DataPoint) UnmarshalJSON(b []byte) error {
+	trimmed := bytes.TrimSpace(b)
+
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+
+	// array of points: only a single point can be represented
+	if trimmed[0] == '[' {
+		var points []dataPointFields
+		if err := json.Unmarshal(trimmed, &points); err != nil {
+			return err
+		}
+		switch len(points) {
+		case 0:
+		case 1:
+			*dp = DataPoint(points[0])
+		default:
+			return fmt.Errorf("cannot decode an array of %d data points into a single DataPoint", len(points))
+		}
+		return nil
+	}
+
+	return json.Unmarshal(trimmed, (*dataPointFields)(dp))
+}
+
+// DataPoints is a list of data points for a single node.
+//
+// GetDataResponse decodes each node's data through DataPoints. It
+// tolerates the different shapes the API may use for a node's
+// data when decoding:
+//   - an array of points is decoded as-is
+//   - a single point object is decoded as a one-element list
+//   - null, an empty object or an empty array decode as an empty list
+type DataPoints []DataPoint
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DataPoints) UnmarshalJSON(b []byte) error {
+	trimmed := bytes.TrimSpace(b)
+
+	// null or empty object: no data for this node
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) || isEmptyObject(trimmed) {
+		*d = DataPoints{}
+		return nil
+	}
+
+	// single point object
+	if trimmed[0] == '{' {
+		var p DataPoint
+		if err := json.Unmarshal(trimmed, &p); err != nil {
+			return err
+		}
+		*d = DataPoints{p}
+		return nil
+	}
+
+	var points []DataPoint
+	if err := json.Unmarshal(trimmed, &points); err != nil {
+		return err
+	}
+	if points == nil {
+		points = []DataPoint{}
+	}
+	*d = points
+	return nil
+}
+
+// isEmptyObject reports whether the trimmed JSON value b is an object
+// without members, such as {} or { }.
+func isEmptyObject(b []byte) bool {
+	return len(b) >= 2 && b[0] == '{' && b[len(b)-1] == '}' &&
+		len(bytes.TrimSpace(b[1:len(b)-1])) == 0
+}
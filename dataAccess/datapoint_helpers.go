@@ -1,6 +1,11 @@
 package dataAccess
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
 
 // AsFloat attempts to decode the DataPoint value as a float64.
 //
@@ -44,3 +49,35 @@ func (dp DataPoint) AsGeo() (GeoValue, bool) {
 
 	return g, true
 }
+
+// Float decodes the DataPoint value of a float variable.
+//
+// Unlike AsFloat, it reports why decoding failed: a value that is not
+// a JSON number returns an error wrapping errors.ErrInvalidValueType.
+func (dp DataPoint) Float() (float64, error) {
+	var v float64
+	if err := json.Unmarshal(dp.Value, &v); err != nil {
+		return 0, &errors.AnedyaError{
+			Message: fmt.Sprintf("value %s is not a float", dp.Value),
+			Err:     errors.ErrInvalidValueType,
+		}
+	}
+	return v, nil
+}
+
+// Geo decodes the DataPoint value of a geo variable into its
+// latitude and longitude.
+//
+// A value that is not a JSON object with lat and long fields returns
+// an error wrapping errors.ErrInvalidValueType. Unlike AsGeo, the
+// coordinate (0, 0) is accepted.
+func (dp DataPoint) Geo() (lat, lng float64, err error) {
+	var g GeoValue
+	if err := json.Unmarshal(dp.Value, &g); err != nil {
+		return 0, 0, &errors.AnedyaError{
+			Message: fmt.Sprintf("value %s is not a geo coordinate", dp.Value),
+			Err:     errors.ErrInvalidValueType,
+		}
+	}
+	return g.Lat, g.Long, nil
+}
@@ -0,0 +1,67 @@
+package dataAccess_test
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestDataPointFloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    float64
+		wantErr error
+	}{
+		{name: "decimal", value: `21.5`, want: 21.5},
+		{name: "integer", value: `-3`, want: -3},
+		{name: "zero", value: `0`, want: 0},
+		{name: "string", value: `"21.5"`, wantErr: errors.ErrInvalidValueType},
+		{name: "geo", value: `{"lat":1,"long":2}`, wantErr: errors.ErrInvalidValueType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dp := dataAccess.DataPoint{Value: json.RawMessage(tt.value)}
+			got, err := dp.Float()
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("Float() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Float() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataPointGeo(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		wantLat  float64
+		wantLong float64
+		wantErr  error
+	}{
+		{name: "coordinate", value: `{"lat":12.97,"long":77.59}`, wantLat: 12.97, wantLong: 77.59},
+		{name: "origin", value: `{"lat":0,"long":0}`},
+		{name: "missing long", value: `{"lat":12.97}`, wantErr: errors.ErrInvalidValueType},
+		{name: "float", value: `21.5`, wantErr: errors.ErrInvalidValueType},
+		{name: "string", value: `"12.97,77.59"`, wantErr: errors.ErrInvalidValueType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dp := dataAccess.DataPoint{Value: json.RawMessage(tt.value)}
+			lat, long, err := dp.Geo()
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("Geo() error = %v, want %v", err, tt.wantErr)
+			}
+			if lat != tt.wantLat || long != tt.wantLong {
+				t.Fatalf("Geo() = (%v, %v), want (%v, %v)", lat, long, tt.wantLat, tt.wantLong)
+			}
+		})
+	}
+}
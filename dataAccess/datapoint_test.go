@@ -0,0 +1,144 @@
+package dataAccess_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGetDataPayloadShapes(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		wantT []int64
+	}{
+		{name: "array", data: `[{"timestamp":1,"value":1},{"timestamp":2,"value":2}]`, wantT: []int64{1, 2}},
+		{name: "single object", data: `{"timestamp":3,"value":3}`, wantT: []int64{3}},
+		{name: "empty object", data: `{}`, wantT: nil},
+		{name: "empty array", data: `[]`, wantT: nil},
+		{name: "null", data: `null`, wantT: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointDataGetData, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"success":true,"variable":"temp","data":{"n1":` + tt.data + `}}`))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			resp, err := client.DataManagement.GetData(context.Background(), &dataAccess.GetDataRequest{
+				Variable: "temp",
+				Nodes:    []string{"n1"},
+				From:     1,
+				To:       10,
+			})
+			if err != nil {
+				t.Fatalf("GetData() error = %v", err)
+			}
+			if resp.Variable != "temp" {
+				t.Fatalf("Variable = %q, want %q", resp.Variable, "temp")
+			}
+
+			points := resp.Data["n1"]
+			if len(points) != len(tt.wantT) {
+				t.Fatalf("got %d points, want %d", len(points), len(tt.wantT))
+			}
+			for i, p := range points {
+				if p.Timestamp != tt.wantT[i] {
+					t.Fatalf("points[%d].Timestamp = %d, want %d", i, p.Timestamp, tt.wantT[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDataPointUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantT     int64
+		wantValue string
+		wantErr   bool
+	}{
+		{name: "object", data: `{"timestamp":5,"value":"a"}`, wantT: 5, wantValue: `"a"`},
+		{name: "one-element array", data: `[{"timestamp":9,"value":"b"}]`, wantT: 9, wantValue: `"b"`},
+		{name: "several points", data: `[{"timestamp":5,"value":"a"},{"timestamp":9,"value":"b"}]`, wantErr: true},
+		{name: "empty array", data: `[]`},
+		{name: "null", data: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dp dataAccess.DataPoint
+			err := json.Unmarshal([]byte(tt.data), &dp)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Unmarshal() decoded %+v, want an error", dp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if dp.Timestamp != tt.wantT || string(dp.Value) != tt.wantValue {
+				t.Fatalf("DataPoint = {%d %s}, want {%d %s}", dp.Timestamp, dp.Value, tt.wantT, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestGetSnapshotArrayPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantT   int64
+		wantErr error
+	}{
+		{name: "one-element array", data: `[{"timestamp":4,"value":4}]`, wantT: 4},
+		{name: "several points", data: `[{"timestamp":1,"value":1},{"timestamp":4,"value":4}]`, wantErr: errors.ErrResponseDecodeFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointDataSnapshot, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"success":true,"count":1,"data":{"n1":` + tt.data + `}}`))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			resp, err := client.DataManagement.GetSnapshot(context.Background(), &dataAccess.GetSnapshotRequest{
+				Variable:  "temp",
+				Nodes:     []string{"n1"},
+				Timestamp: 10,
+			})
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("GetSnapshot() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetSnapshot() error = %v", err)
+			}
+			if got := resp.Data["n1"].Timestamp; got != tt.wantT {
+				t.Fatalf("Data[n1].Timestamp = %d, want %d", got, tt.wantT)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package dataAccess
+
+import (
+	"context"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// ForEachDataWindow reads historical data for req in consecutive time
+// windows and calls fn with the response of each window.
+//
+// The req.From..req.To range is split into windows of the given
+// duration; each window covers [start, start+window-1ms] and the last
+// one is clipped to req.To. Only one window is held in memory at a
+// time, which bounds memory use for large ranges.
+//
+// Iteration stops at the first error returned by GetData or fn, or
+// when ctx is cancelled, and that error is returned. Each window is
+// subject to req.Limit like a regular GetData call; use a window small
+// enough to stay within it.
+//
+// Parameters:
+//   - ctx: Context used to control request lifecycle, cancellation, and deadlines.
+//   - req: Pointer to GetDataRequest describing the full query range.
+//   - window: Length of each time window; must be at least one millisecond.
+//   - fn: Callback invoked with the data of each window, in range order.
+//
+// Returns:
+//   - nil if every window was read and processed successfully.
+//   - The first validation, request, fn or context error otherwise.
+func (dm *DataManagement) ForEachDataWindow(
+	ctx context.Context,
+	req *GetDataRequest,
+	window time.Duration,
+	fn func(*GetDataResponse) error,
+) error {
+
+	// check if request is nil
+	if req == nil {
+		return &errors.AnedyaError{
+			Message: "get data request cannot be nil",
+			Err:     errors.ErrRequestNil,
+		}
+	}
+
+	// callback is mandatory
+	if fn == nil {
+		return &errors.AnedyaError{
+			Message: "window callback cannot be nil",
+			Err:     errors.ErrInputRequired,
+		}
+	}
+
+	// window must cover at least one millisecond
+	step := window.Milliseconds()
+	if step <= 0 {
+		return errors.NewFieldError("window", window, "must be at least one millisecond", errors.ErrInvalidTimeRange)
+	}
+
+	// validate timestamp range
+	if err := validateTimeRange(req.From, req.To); err != nil {
+		return err
+	}
+
+	for start := req.From; start <= req.To; start += step {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		windowReq := *req
+		windowReq.From = start
+		windowReq.To = min(start+step-1, req.To)
+
+		resp, err := dm.GetData(ctx, &windowReq)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
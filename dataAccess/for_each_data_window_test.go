@@ -0,0 +1,221 @@
+package dataAccess_test
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// window is the [From, To] range of a single GetData request.
+type window struct{ from, to int64 }
+
+func TestForEachDataWindowBoundaries(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to int64
+		window   time.Duration
+		want     []window
+	}{
+		{
+			name:   "range divides evenly",
+			from:   1000,
+			to:     3999,
+			window: time.Second,
+			want:   []window{{1000, 1999}, {2000, 2999}, {3000, 3999}},
+		},
+		{
+			name:   "last window is clipped",
+			from:   1000,
+			to:     3500,
+			window: time.Second,
+			want:   []window{{1000, 1999}, {2000, 2999}, {3000, 3500}},
+		},
+		{
+			name:   "window larger than range",
+			from:   1000,
+			to:     1500,
+			window: time.Hour,
+			want:   []window{{1000, 1500}},
+		},
+		{
+			name:   "single millisecond",
+			from:   1000,
+			to:     1000,
+			window: time.Second,
+			want:   []window{{1000, 1000}},
+		},
+		{
+			name:   "one millisecond windows",
+			from:   1,
+			to:     3,
+			window: time.Millisecond,
+			want:   []window{{1, 1}, {2, 2}, {3, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var requested []window
+			mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+				requested = append(requested, window{req.From, req.To})
+				return map[string][]dataAccess.DataPoint{
+					"n1": {{Timestamp: req.From}},
+				}, nil
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			var seen []int64
+			err := client.DataManagement.ForEachDataWindow(context.Background(), &dataAccess.GetDataRequest{
+				Variable: "temp",
+				Nodes:    []string{"n1"},
+				From:     tt.from,
+				To:       tt.to,
+			}, tt.window, func(resp *dataAccess.GetDataResponse) error {
+				seen = append(seen, resp.Data["n1"][0].Timestamp)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("ForEachDataWindow() error = %v", err)
+			}
+			if !reflect.DeepEqual(requested, tt.want) {
+				t.Fatalf("requested windows = %v, want %v", requested, tt.want)
+			}
+
+			// fn must see every window, in range order
+			if len(seen) != len(tt.want) {
+				t.Fatalf("fn called %d times, want %d", len(seen), len(tt.want))
+			}
+			for i, w := range tt.want {
+				if seen[i] != w.from {
+					t.Fatalf("fn call %d saw window starting at %d, want %d", i, seen[i], w.from)
+				}
+			}
+		})
+	}
+}
+
+func TestForEachDataWindowStops(t *testing.T) {
+	errStop := stderrors.New("stop")
+
+	tests := []struct {
+		name string
+		// stopAt is the window index at which fn fails or cancels.
+		stopAt       int
+		cancel       bool
+		wantErr      error
+		wantRequests int
+	}{
+		{name: "fn error on first window", stopAt: 0, wantErr: errStop, wantRequests: 1},
+		{name: "fn error on later window", stopAt: 2, wantErr: errStop, wantRequests: 3},
+		{name: "context cancelled", stopAt: 1, cancel: true, wantErr: context.Canceled, wantRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			requests := 0
+			mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+				requests++
+				return nil, nil
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			calls := 0
+			err := client.DataManagement.ForEachDataWindow(ctx, &dataAccess.GetDataRequest{
+				Variable: "temp",
+				Nodes:    []string{"n1"},
+				From:     1,
+				To:       10_000,
+			}, time.Second, func(resp *dataAccess.GetDataResponse) error {
+				defer func() { calls++ }()
+				if calls != tt.stopAt {
+					return nil
+				}
+				if tt.cancel {
+					cancel()
+					return nil
+				}
+				return errStop
+			})
+
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("ForEachDataWindow() error = %v, want %v", err, tt.wantErr)
+			}
+			if requests != tt.wantRequests {
+				t.Fatalf("made %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestForEachDataWindowValidation(t *testing.T) {
+	valid := dataAccess.GetDataRequest{Variable: "temp", Nodes: []string{"n1"}, From: 1, To: 2}
+	reversed := valid
+	reversed.From, reversed.To = 2, 1
+	noFrom := valid
+	noFrom.From = 0
+	noTo := valid
+	noTo.To = 0
+	noop := func(*dataAccess.GetDataResponse) error { return nil }
+
+	tests := []struct {
+		name    string
+		req     *dataAccess.GetDataRequest
+		window  time.Duration
+		fn      func(*dataAccess.GetDataResponse) error
+		wantErr error
+		// wantField is the field named by the FieldError, if any.
+		wantField string
+	}{
+		{name: "nil request", window: time.Second, fn: noop, wantErr: errors.ErrRequestNil},
+		{name: "nil callback", req: &valid, window: time.Second, wantErr: errors.ErrInputRequired},
+		{name: "sub-millisecond window", req: &valid, window: time.Microsecond, fn: noop, wantErr: errors.ErrInvalidTimeRange, wantField: "window"},
+		{name: "reversed range", req: &reversed, window: time.Second, fn: noop, wantErr: errors.ErrInvalidTimeRange, wantField: "from"},
+		{name: "missing from", req: &noFrom, window: time.Second, fn: noop, wantErr: errors.ErrInvalidTimeRange, wantField: "from"},
+		{name: "missing to", req: &noTo, window: time.Second, fn: noop, wantErr: errors.ErrInvalidTimeRange, wantField: "to"},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.DataManagement.ForEachDataWindow(context.Background(), tt.req, tt.window, tt.fn)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("ForEachDataWindow() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantField == "" {
+				return
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package dataAccess
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// NewGeoValue returns a GeoValue for the given coordinate,
+// or an error wrapping errors.ErrInvalidGeoValue when it is
+// out of range.
+func NewGeoValue(lat, long float64) (GeoValue, error) {
+	g := GeoValue{Lat: lat, Long: long}
+	if err := g.Validate(); err != nil {
+		return GeoValue{}, err
+	}
+	return g, nil
+}
+
+// Validate reports whether the latitude is within [-90, 90] and
+// the longitude within [-180, 180].
+func (g GeoValue) Validate() error {
+	if g.Lat < -90 || g.Lat > 90 {
+		return errors.NewFieldError("lat", g.Lat, "must be between -90 and 90", errors.ErrInvalidGeoValue)
+	}
+	if g.Long < -180 || g.Long > 180 {
+		return errors.NewFieldError("long", g.Long, "must be between -180 and 180", errors.ErrInvalidGeoValue)
+	}
+	return nil
+}
+
+// geoValueJSON is the wire format of a geo variable value.
+type geoValueJSON struct {
+	Lat  *float64 `json:"lat"`
+	Long *float64 `json:"long"`
+}
+
+// MarshalJSON encodes the coordinate in the platform format
+// {"lat": ..., "long": ...}, rejecting out-of-range values.
+func (g GeoValue) MarshalJSON() ([]byte, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(geoValueJSON{Lat: &g.Lat, Long: &g.Long})
+}
+
+// UnmarshalJSON decodes a coordinate in the platform format.
+// Both lat and long must be present.
+func (g *GeoValue) UnmarshalJSON(data []byte) error {
+	var v geoValueJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.Lat == nil || v.Long == nil {
+		return &errors.AnedyaError{
+			Message: fmt.Sprintf("value %s is not a geo coordinate", data),
+			Err:     errors.ErrInvalidValueType,
+		}
+	}
+	g.Lat, g.Long = *v.Lat, *v.Long
+	return nil
+}
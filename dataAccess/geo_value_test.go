@@ -0,0 +1,109 @@
+package dataAccess_test
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGeoValueValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		geo       dataAccess.GeoValue
+		wantField string // empty when the value is valid
+	}{
+		{name: "inside range", geo: dataAccess.GeoValue{Lat: 12.97, Long: 77.59}},
+		{name: "origin", geo: dataAccess.GeoValue{}},
+		{name: "range limits", geo: dataAccess.GeoValue{Lat: -90, Long: 180}},
+		{name: "latitude too high", geo: dataAccess.GeoValue{Lat: 90.1}, wantField: "lat"},
+		{name: "latitude too low", geo: dataAccess.GeoValue{Lat: -91}, wantField: "lat"},
+		{name: "longitude too high", geo: dataAccess.GeoValue{Long: 181}, wantField: "long"},
+		{name: "longitude too low", geo: dataAccess.GeoValue{Long: -180.5}, wantField: "long"},
+		{name: "both out of range", geo: dataAccess.GeoValue{Lat: 100, Long: 200}, wantField: "lat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGeoError(t, "Validate()", tt.geo.Validate(), tt.wantField)
+
+			_, err := dataAccess.NewGeoValue(tt.geo.Lat, tt.geo.Long)
+			checkGeoError(t, "NewGeoValue()", err, tt.wantField)
+
+			b, err := json.Marshal(tt.geo)
+			checkGeoError(t, "json.Marshal()", err, tt.wantField)
+			if tt.wantField != "" {
+				return
+			}
+
+			var got dataAccess.GeoValue
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", b, err)
+			}
+			if got != tt.geo {
+				t.Fatalf("round trip = %+v, want %+v", got, tt.geo)
+			}
+		})
+	}
+}
+
+// checkGeoError fails the test unless err is nil when wantField is
+// empty, or a FieldError on wantField wrapping ErrInvalidGeoValue.
+func checkGeoError(t *testing.T, call string, err error, wantField string) {
+	t.Helper()
+
+	if wantField == "" {
+		if err != nil {
+			t.Fatalf("%s error = %v, want nil", call, err)
+		}
+		return
+	}
+	if !stderrors.Is(err, errors.ErrInvalidGeoValue) {
+		t.Fatalf("%s error = %v, want %v", call, err, errors.ErrInvalidGeoValue)
+	}
+	var fe *errors.FieldError
+	if !stderrors.As(err, &fe) {
+		t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+	}
+	if fe.Field != wantField {
+		t.Fatalf("FieldError.Field = %q, want %q", fe.Field, wantField)
+	}
+}
+
+func TestGeoValueMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(dataAccess.GeoValue{Lat: 12.5, Long: -0.25})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"lat":12.5,"long":-0.25}`; string(b) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", b, want)
+	}
+}
+
+func TestGeoValueUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    dataAccess.GeoValue
+		wantErr error
+	}{
+		{name: "coordinate", data: `{"lat":12.5,"long":-0.25}`, want: dataAccess.GeoValue{Lat: 12.5, Long: -0.25}},
+		{name: "missing lat", data: `{"long":1}`, wantErr: errors.ErrInvalidValueType},
+		{name: "missing long", data: `{"lat":1}`, wantErr: errors.ErrInvalidValueType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got dataAccess.GeoValue
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("json.Unmarshal() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("json.Unmarshal() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package dataAccess
+
+import (
+	"context"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// GetDataChunked retrieves historical data for a large node list by
+// splitting req.Nodes into chunks of at most nodeChunkSize nodes and
+// issuing one GetData request per chunk.
+//
+// At most common.MaxConcurrentRequests chunks are read in parallel.
+// Chunks are disjoint, so the per-node data of every chunk is merged
+// into a single response whose Count is the sum of the chunk counts.
+//
+// The first failing chunk cancels the chunks still outstanding. The
+// data merged from the chunks that completed before the failure is
+// returned together with the first error, so callers can keep partial
+// results.
+//
+// Parameters:
+//   - ctx: Context used to control request lifecycle, cancellation, and deadlines.
+//   - req: Pointer to GetDataRequest; its Nodes are split into chunks.
+//   - nodeChunkSize: Maximum number of nodes per request; must be greater than 0.
+//
+// Returns:
+//   - (*GetDataResponse, nil) if every chunk is fetched successfully.
+//   - (nil, error) for validation failures.
+//   - (*GetDataResponse, error) with partial data when a chunk fails.
+func (dm *DataManagement) GetDataChunked(
+	ctx context.Context,
+	req *GetDataRequest,
+	nodeChunkSize int,
+) (*GetDataResponse, error) {
+
+	// check if request is nil
+	if req == nil {
+		return nil, &errors.AnedyaError{
+			Message: "get data request cannot be nil",
+			Err:     errors.ErrRequestNil,
+		}
+	}
+
+	// validate chunk size
+	if nodeChunkSize <= 0 {
+		return nil, errors.NewFieldError("nodeChunkSize", nodeChunkSize, "must be greater than 0", errors.ErrInvalidInput)
+	}
+
+	// at least one node must be provided
+	if len(req.Nodes) == 0 {
+		return nil, errors.NewFieldError("nodes", req.Nodes, "must contain at least one node", errors.ErrNodesEmpty)
+	}
+
+	// validate the range once rather than failing in every chunk
+	if err := validateTimeRange(req.From, req.To); err != nil {
+		return nil, err
+	}
+
+	result := &GetDataResponse{
+		Success:  true,
+		Variable: req.Variable,
+		Data:     make(map[string][]DataPoint, len(req.Nodes)),
+	}
+
+	chunks := (len(req.Nodes) + nodeChunkSize - 1) / nodeChunkSize
+
+	err := common.FanOut(ctx, chunks,
+		func(ctx context.Context, i int) (*GetDataResponse, error) {
+			start := i * nodeChunkSize
+			end := min(start+nodeChunkSize, len(req.Nodes))
+
+			chunkReq := *req
+			chunkReq.Nodes = req.Nodes[start:end]
+			return dm.GetData(ctx, &chunkReq)
+		},
+		func(i int, resp *GetDataResponse) {
+			for node, points := range resp.Data {
+				result.Data[node] = points
+			}
+			result.Count += resp.Count
+		},
+	)
+	if err != nil {
+		result.Success = false
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,193 @@
+package dataAccess_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGetDataChunked(t *testing.T) {
+	tests := []struct {
+		name       string
+		nodes      int
+		chunkSize  int
+		wantChunks []int // sorted chunk sizes
+	}{
+		{name: "uneven chunks", nodes: 10, chunkSize: 3, wantChunks: []int{1, 3, 3, 3}},
+		{name: "even chunks", nodes: 8, chunkSize: 4, wantChunks: []int{4, 4}},
+		{name: "single chunk", nodes: 5, chunkSize: 5, wantChunks: []int{5}},
+		{name: "chunk larger than node list", nodes: 2, chunkSize: 100, wantChunks: []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var mu sync.Mutex
+			var chunks [][]string
+			mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+				mu.Lock()
+				chunks = append(chunks, req.Nodes)
+				mu.Unlock()
+
+				// two points per node
+				data := make(map[string][]dataAccess.DataPoint, len(req.Nodes))
+				for _, id := range req.Nodes {
+					data[id] = []dataAccess.DataPoint{
+						{Timestamp: req.From, Value: json.RawMessage(`1`)},
+						{Timestamp: req.To, Value: json.RawMessage(`2`)},
+					}
+				}
+				return data, nil
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			ids := make([]string, tt.nodes)
+			for i := range ids {
+				ids[i] = fmt.Sprintf("n%d", i)
+			}
+
+			resp, err := client.DataManagement.GetDataChunked(context.Background(), &dataAccess.GetDataRequest{
+				Variable: "temp",
+				Nodes:    ids,
+				From:     1,
+				To:       2,
+			}, tt.chunkSize)
+			if err != nil {
+				t.Fatalf("GetDataChunked() error = %v", err)
+			}
+
+			// chunks must be disjoint, within size and cover every node
+			var sizes []int
+			seen := make(map[string]bool, tt.nodes)
+			for _, chunk := range chunks {
+				sizes = append(sizes, len(chunk))
+				for _, id := range chunk {
+					if seen[id] {
+						t.Fatalf("node %s requested in more than one chunk", id)
+					}
+					seen[id] = true
+				}
+			}
+			slices.Sort(sizes)
+			if !slices.Equal(sizes, tt.wantChunks) {
+				t.Fatalf("chunk sizes = %v, want %v", sizes, tt.wantChunks)
+			}
+			if len(seen) != tt.nodes {
+				t.Fatalf("requested %d distinct nodes, want %d", len(seen), tt.nodes)
+			}
+
+			// the merged response must hold every node's data
+			if !resp.Success || resp.Variable != "temp" {
+				t.Fatalf("response = %+v, want a successful response for temp", resp)
+			}
+			if len(resp.Data) != tt.nodes {
+				t.Fatalf("merged data for %d nodes, want %d", len(resp.Data), tt.nodes)
+			}
+			for _, id := range ids {
+				if len(resp.Data[id]) != 2 {
+					t.Fatalf("Data[%s] has %d points, want 2", id, len(resp.Data[id]))
+				}
+			}
+			if resp.Count != 2*tt.nodes {
+				t.Fatalf("Count = %d, want %d", resp.Count, 2*tt.nodes)
+			}
+		})
+	}
+}
+
+func TestGetDataChunkedError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+		if slices.Contains(req.Nodes, "bad") {
+			return nil, &errors.AnedyaError{
+				Message:    "variable not found",
+				ReasonCode: errors.ReasonDataVariableNotFound,
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		data := make(map[string][]dataAccess.DataPoint, len(req.Nodes))
+		for _, id := range req.Nodes {
+			data[id] = []dataAccess.DataPoint{{Timestamp: 1, Value: json.RawMessage(`1`)}}
+		}
+		return data, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	resp, err := client.DataManagement.GetDataChunked(context.Background(), &dataAccess.GetDataRequest{
+		Variable: "temp",
+		Nodes:    []string{"n1", "n2", "bad", "n3"},
+		From:     1,
+		To:       2,
+	}, 1)
+
+	if !stderrors.Is(err, errors.ErrVariableNotFound) {
+		t.Fatalf("GetDataChunked() error = %v, want %v", err, errors.ErrVariableNotFound)
+	}
+	if resp == nil || resp.Success {
+		t.Fatalf("response = %+v, want an unsuccessful partial response", resp)
+	}
+	if _, ok := resp.Data["bad"]; ok {
+		t.Fatal("partial response holds data for the failing chunk")
+	}
+}
+
+func TestGetDataChunkedValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *dataAccess.GetDataRequest
+		chunkSize int
+		wantErr   error
+		// wantField is the field named by the FieldError, if any.
+		wantField string
+	}{
+		{name: "nil request", chunkSize: 1, wantErr: errors.ErrRequestNil},
+		{name: "zero chunk size", req: &dataAccess.GetDataRequest{Nodes: []string{"n1"}, From: 1, To: 2}, wantErr: errors.ErrInvalidInput, wantField: "nodeChunkSize"},
+		{name: "no nodes", req: &dataAccess.GetDataRequest{From: 1, To: 2}, chunkSize: 1, wantErr: errors.ErrNodesEmpty, wantField: "nodes"},
+		{name: "missing from", req: &dataAccess.GetDataRequest{Nodes: []string{"n1"}, To: 2}, chunkSize: 1, wantErr: errors.ErrInvalidTimeRange, wantField: "from"},
+		{name: "missing to", req: &dataAccess.GetDataRequest{Nodes: []string{"n1"}, From: 1}, chunkSize: 1, wantErr: errors.ErrInvalidTimeRange, wantField: "to"},
+		{name: "reversed range", req: &dataAccess.GetDataRequest{Nodes: []string{"n1"}, From: 3, To: 2}, chunkSize: 1, wantErr: errors.ErrInvalidTimeRange, wantField: "from"},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.DataManagement.GetDataChunked(context.Background(), tt.req, tt.chunkSize)
+			if resp != nil || !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("GetDataChunked() = %v, %v, want nil, %v", resp, err, tt.wantErr)
+			}
+			if tt.wantField == "" {
+				return
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+}
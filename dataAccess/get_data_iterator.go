@@ -0,0 +1,159 @@
+package dataAccess
+
+import (
+	"context"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// DataIterator pages through historical data returned by GetData
+// for ranges that do not fit in a single response.
+//
+// Nodes are read one after another. For each node the query window is
+// narrowed past the last returned timestamp after every page, until a
+// page comes back empty or the window is exhausted.
+//
+// Use Next to advance the iterator and Value to read the current
+// node ID and data point. Once Next returns false, Err reports the
+// error that stopped the iteration, if any.
+type DataIterator struct {
+	ctx context.Context
+	dm  *DataManagement
+	req GetDataRequest
+
+	// nodeIndex is the index of the node currently being read.
+	nodeIndex int
+
+	// from and to are the query window for the next page
+	// of the current node.
+	from int64
+	to   int64
+
+	// nodeDone is set once the window of the current node is exhausted.
+	nodeDone bool
+
+	page    []DataPoint
+	index   int
+	current DataPoint
+
+	err error
+}
+
+// GetDataIterator returns an iterator over all data points matching req.
+//
+// req.Limit is used as the page size. When req.Order is empty, points
+// are read in ascending order. With "asc" ordering the next page starts
+// one millisecond after the last returned timestamp; with "desc"
+// ordering it ends one millisecond before it.
+//
+// Points sharing the exact millisecond of a page boundary may be
+// skipped if the page limit splits them.
+func (dm *DataManagement) GetDataIterator(ctx context.Context, req *GetDataRequest) *DataIterator {
+	if req == nil {
+		return &DataIterator{
+			err: &errors.AnedyaError{
+				Message: "get data request cannot be nil",
+				Err:     errors.ErrRequestNil,
+			},
+		}
+	}
+
+	it := &DataIterator{
+		ctx:  ctx,
+		dm:   dm,
+		req:  *req,
+		from: req.From,
+		to:   req.To,
+	}
+	if it.req.Order == "" {
+		it.req.Order = "asc"
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next data point.
+//
+// It returns false when all data has been read or an error
+// occurred; use Err to tell the two apart.
+func (it *DataIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if it.err != nil || it.nodeIndex >= len(it.req.Nodes) {
+			return false
+		}
+
+		// move on to the next node once the current one is exhausted
+		if it.nodeDone {
+			it.nextNode()
+			continue
+		}
+
+		it.fetch()
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the node ID and data point at the current
+// iterator position.
+func (it *DataIterator) Value() (string, DataPoint) {
+	return it.req.Nodes[it.nodeIndex], it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DataIterator) Err() error {
+	return it.err
+}
+
+// nextNode resets the query window for the following node.
+func (it *DataIterator) nextNode() {
+	it.nodeIndex++
+	it.from = it.req.From
+	it.to = it.req.To
+	it.nodeDone = false
+	it.page = nil
+	it.index = 0
+}
+
+// fetch loads the next page for the current node and narrows
+// its query window.
+func (it *DataIterator) fetch() {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return
+	}
+
+	node := it.req.Nodes[it.nodeIndex]
+
+	pageReq := it.req
+	pageReq.Nodes = []string{node}
+	pageReq.From = it.from
+	pageReq.To = it.to
+
+	resp, err := it.dm.GetData(it.ctx, &pageReq)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page = resp.Data[node]
+	it.index = 0
+
+	if len(it.page) == 0 {
+		it.nodeDone = true
+		return
+	}
+
+	last := it.page[len(it.page)-1].Timestamp
+	if it.req.Order == "desc" {
+		it.to = last - 1
+	} else {
+		it.from = last + 1
+	}
+
+	if it.from > it.to || it.from <= 0 || it.to <= 0 {
+		it.nodeDone = true
+	}
+}
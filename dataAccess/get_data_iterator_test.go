@@ -0,0 +1,197 @@
+package dataAccess_test
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// servePoints stubs Get Data to serve the given timestamps per node,
+// honouring the requested range, order and limit. It returns the
+// number of requests served so far.
+func servePoints(mock *anedyatest.MockServer, data map[string][]int64) *int {
+	requests := new(int)
+	mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+		*requests++
+
+		var points []dataAccess.DataPoint
+		for _, ts := range data[req.Nodes[0]] {
+			if ts >= req.From && ts <= req.To {
+				points = append(points, dataAccess.DataPoint{Timestamp: ts})
+			}
+		}
+		slices.SortFunc(points, func(a, b dataAccess.DataPoint) int {
+			if req.Order == "desc" {
+				return int(b.Timestamp - a.Timestamp)
+			}
+			return int(a.Timestamp - b.Timestamp)
+		})
+		if req.Limit > 0 && len(points) > req.Limit {
+			points = points[:req.Limit]
+		}
+
+		return map[string][]dataAccess.DataPoint{req.Nodes[0]: points}, nil
+	})
+	return requests
+}
+
+// point is a node ID and timestamp read from a DataIterator.
+type point struct {
+	node string
+	ts   int64
+}
+
+func TestGetDataIterator(t *testing.T) {
+	data := map[string][]int64{
+		"n1": {10, 20, 30, 40, 50},
+		"n2": {15, 25},
+	}
+
+	tests := []struct {
+		name         string
+		order        string
+		limit        int
+		from, to     int64
+		want         []point
+		wantRequests int
+	}{
+		{
+			name:  "ascending pages",
+			limit: 2,
+			from:  1,
+			to:    100,
+			want: []point{
+				{"n1", 10}, {"n1", 20}, {"n1", 30}, {"n1", 40}, {"n1", 50},
+				{"n2", 15}, {"n2", 25},
+			},
+			// n1: 3 pages and an empty one; n2: 1 page and an empty one
+			wantRequests: 6,
+		},
+		{
+			name:  "descending pages",
+			order: "desc",
+			limit: 2,
+			from:  1,
+			to:    100,
+			want: []point{
+				{"n1", 50}, {"n1", 40}, {"n1", 30}, {"n1", 20}, {"n1", 10},
+				{"n2", 25}, {"n2", 15},
+			},
+			wantRequests: 6,
+		},
+		{
+			name:  "window exhausted at the last point",
+			limit: 2,
+			from:  20,
+			to:    40,
+			want:  []point{{"n1", 20}, {"n1", 30}, {"n1", 40}, {"n2", 25}},
+			// the n1 window ends at 40, so no empty page is requested
+			wantRequests: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			requests := servePoints(mock, data)
+
+			client := mock.Client()
+			defer client.Close()
+
+			it := client.DataManagement.GetDataIterator(context.Background(), &dataAccess.GetDataRequest{
+				Variable: "temp",
+				Nodes:    []string{"n1", "n2"},
+				From:     tt.from,
+				To:       tt.to,
+				Limit:    tt.limit,
+				Order:    tt.order,
+			})
+
+			var got []point
+			for it.Next() {
+				node, p := it.Value()
+				got = append(got, point{node, p.Timestamp})
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("iterated %v, want %v", got, tt.want)
+			}
+
+			// an exhausted iterator stays exhausted without new requests
+			if it.Next() {
+				t.Fatal("Next() = true after the last point")
+			}
+			if *requests != tt.wantRequests {
+				t.Fatalf("made %d requests, want %d", *requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestGetDataIteratorError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.OnGetData(func(req dataAccess.GetDataRequest) (map[string][]dataAccess.DataPoint, error) {
+		requests++
+		if requests > 1 {
+			return nil, &errors.AnedyaError{Message: "node not found", ReasonCode: errors.ReasonNodeNotFound}
+		}
+		return map[string][]dataAccess.DataPoint{"n1": {{Timestamp: 1}, {Timestamp: 2}}}, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	it := client.DataManagement.GetDataIterator(context.Background(), &dataAccess.GetDataRequest{
+		Variable: "temp",
+		Nodes:    []string{"n1"},
+		From:     1,
+		To:       100,
+		Limit:    2,
+	})
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 {
+		t.Fatalf("iterated %d points, want the 2 of the first page", count)
+	}
+	if !stderrors.Is(it.Err(), errors.ErrNodeNotFound) {
+		t.Fatalf("Err() = %v, want %v", it.Err(), errors.ErrNodeNotFound)
+	}
+	if it.Next() {
+		t.Fatal("Next() = true after an error")
+	}
+	if requests != 2 {
+		t.Fatalf("made %d requests, want 2", requests)
+	}
+}
+
+func TestGetDataIteratorNilRequest(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	it := client.DataManagement.GetDataIterator(context.Background(), nil)
+	if it.Next() {
+		t.Fatal("Next() = true for a nil request")
+	}
+	if !stderrors.Is(it.Err(), errors.ErrRequestNil) {
+		t.Fatalf("Err() = %v, want %v", it.Err(), errors.ErrRequestNil)
+	}
+}
@@ -0,0 +1,75 @@
+package dataAccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// GetLatestDataMulti retrieves the most recent data value of several
+// variables across one or more nodes.
+//
+// The Get Latest Data API accepts a single variable, so one request is
+// issued per variable, with at most common.MaxConcurrentRequests running in
+// parallel. The first failure cancels the remaining requests and is
+// returned.
+//
+// Parameters:
+//   - ctx: Context used to control request lifecycle, cancellation, and deadlines.
+//   - variables: Names of the variables whose latest values are requested.
+//   - nodes: Node IDs for which the latest data is requested.
+//
+// Returns:
+//   - A map of variable name to a map of node ID to the latest data point.
+//   - An error if validation fails or any request fails.
+func (dm *DataManagement) GetLatestDataMulti(
+	ctx context.Context,
+	variables []string,
+	nodes []string,
+) (map[string]map[string]DataPoint, error) {
+
+	// at least one variable must be provided
+	if len(variables) == 0 {
+		return nil, errors.NewFieldError("variables", variables, "must contain at least one variable", errors.ErrVariableRequired)
+	}
+
+	// validate each variable name
+	for i, v := range variables {
+		if v == "" {
+			return nil, errors.NewFieldError(fmt.Sprintf("variables[%d]", i), v, "must not be empty", errors.ErrVariableRequired)
+		}
+	}
+
+	// at least one node must be provided
+	if len(nodes) == 0 {
+		return nil, errors.NewFieldError("nodes", nodes, "must contain at least one node", errors.ErrNodesEmpty)
+	}
+
+	// validate each node ID
+	for i, node := range nodes {
+		if node == "" {
+			return nil, errors.NewFieldError(fmt.Sprintf("nodes[%d]", i), node, "must not be empty", errors.ErrInvalidNode)
+		}
+	}
+
+	result := make(map[string]map[string]DataPoint, len(variables))
+
+	err := common.FanOut(ctx, len(variables),
+		func(ctx context.Context, i int) (*GetLatestDataResponse, error) {
+			return dm.GetLatestData(ctx, &GetLatestDataRequest{
+				Nodes:    nodes,
+				Variable: variables[i],
+			})
+		},
+		func(i int, resp *GetLatestDataResponse) {
+			result[variables[i]] = resp.Data
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
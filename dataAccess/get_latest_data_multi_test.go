@@ -0,0 +1,115 @@
+package dataAccess_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestGetLatestDataMulti(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var requests atomic.Int32
+	mock.OnLatestData(func(req dataAccess.GetLatestDataRequest) (map[string]dataAccess.DataPoint, error) {
+		requests.Add(1)
+
+		// encode the variable in the value so results can be matched
+		data := make(map[string]dataAccess.DataPoint, len(req.Nodes))
+		for _, node := range req.Nodes {
+			data[node] = dataAccess.DataPoint{Timestamp: 1, Value: json.RawMessage(`"` + req.Variable + "/" + node + `"`)}
+		}
+		return data, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	variables := []string{"temp", "humidity", "pressure", "co2", "voltage"}
+	nodes := []string{"n1", "n2"}
+
+	got, err := client.DataManagement.GetLatestDataMulti(context.Background(), variables, nodes)
+	if err != nil {
+		t.Fatalf("GetLatestDataMulti() error = %v", err)
+	}
+	if n := requests.Load(); n != int32(len(variables)) {
+		t.Fatalf("made %d requests, want one per variable (%d)", n, len(variables))
+	}
+	if len(got) != len(variables) {
+		t.Fatalf("got %d variables, want %d", len(got), len(variables))
+	}
+	for _, v := range variables {
+		for _, node := range nodes {
+			if want := `"` + v + "/" + node + `"`; string(got[v][node].Value) != want {
+				t.Fatalf("result[%s][%s] = %s, want %s", v, node, got[v][node].Value, want)
+			}
+		}
+	}
+}
+
+func TestGetLatestDataMultiError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnLatestData(func(req dataAccess.GetLatestDataRequest) (map[string]dataAccess.DataPoint, error) {
+		if req.Variable == "missing" {
+			return nil, &errors.AnedyaError{Message: "variable not found", ReasonCode: errors.ReasonDataVariableNotFound}
+		}
+		return map[string]dataAccess.DataPoint{"n1": {Timestamp: 1}}, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	got, err := client.DataManagement.GetLatestDataMulti(context.Background(), []string{"temp", "missing"}, []string{"n1"})
+	if !stderrors.Is(err, errors.ErrVariableNotFound) {
+		t.Fatalf("GetLatestDataMulti() error = %v, want %v", err, errors.ErrVariableNotFound)
+	}
+	if got != nil {
+		t.Fatalf("GetLatestDataMulti() = %v, want nil on error", got)
+	}
+}
+
+func TestGetLatestDataMultiValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables []string
+		nodes     []string
+		wantErr   error
+		wantField string
+	}{
+		{name: "no variables", nodes: []string{"n1"}, wantErr: errors.ErrVariableRequired, wantField: "variables"},
+		{name: "empty variable", variables: []string{"temp", ""}, nodes: []string{"n1"}, wantErr: errors.ErrVariableRequired, wantField: "variables[1]"},
+		{name: "no nodes", variables: []string{"temp"}, wantErr: errors.ErrNodesEmpty, wantField: "nodes"},
+		{name: "empty node", variables: []string{"temp"}, nodes: []string{"n1", ""}, wantErr: errors.ErrInvalidNode, wantField: "nodes[1]"},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.DataManagement.GetLatestDataMulti(context.Background(), tt.variables, tt.nodes)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("GetLatestDataMulti() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+}
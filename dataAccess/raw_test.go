@@ -0,0 +1,89 @@
+package dataAccess_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+)
+
+func TestRawVariants(t *testing.T) {
+	methods := []struct {
+		name     string
+		endpoint string
+		body     string
+		call     func(ctx context.Context, dm *dataAccess.DataManagement) (*http.Response, error)
+	}{
+		{
+			name:     "GetDataRaw",
+			endpoint: common.EndpointDataGetData,
+			body:     `{"success":true,"variable":"temp","count":1,"data":{"n1":[{"timestamp":1,"value":1}]}}`,
+			call: func(ctx context.Context, dm *dataAccess.DataManagement) (*http.Response, error) {
+				_, resp, err := dm.GetDataRaw(ctx, &dataAccess.GetDataRequest{Variable: "temp", Nodes: []string{"n1"}, From: 1, To: 2})
+				return resp, err
+			},
+		},
+		{
+			name:     "GetLatestDataRaw",
+			endpoint: common.EndpointDataLatest,
+			body:     `{"success":true,"count":1,"data":{"n1":{"timestamp":1,"value":1}}}`,
+			call: func(ctx context.Context, dm *dataAccess.DataManagement) (*http.Response, error) {
+				_, resp, err := dm.GetLatestDataRaw(ctx, &dataAccess.GetLatestDataRequest{Variable: "temp", Nodes: []string{"n1"}})
+				return resp, err
+			},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		status  int
+		body    string // empty to use the method's success body
+		wantErr bool
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "api error", status: http.StatusServiceUnavailable, body: `{"success":false,"error":"busy"}`, wantErr: true},
+	}
+
+	for _, m := range methods {
+		for _, tc := range cases {
+			t.Run(m.name+"/"+tc.name, func(t *testing.T) {
+				mock := anedyatest.NewServer()
+				defer mock.Close()
+
+				body := tc.body
+				if body == "" {
+					body = m.body
+				}
+				mock.Handle("/"+common.APIVersion+"/"+m.endpoint, func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-Request-Id", "req-456")
+					w.WriteHeader(tc.status)
+					w.Write([]byte(body))
+				})
+
+				client := mock.Client()
+				defer client.Close()
+
+				resp, err := m.call(context.Background(), client.DataManagement)
+				if tc.wantErr != (err != nil) {
+					t.Fatalf("error = %v, want error: %v", err, tc.wantErr)
+				}
+				if resp == nil {
+					t.Fatal("response is nil")
+				}
+				if resp.StatusCode != tc.status {
+					t.Fatalf("StatusCode = %d, want %d", resp.StatusCode, tc.status)
+				}
+				if got := resp.Header.Get("X-Request-Id"); got != "req-456" {
+					t.Fatalf("X-Request-Id = %q, want %q", got, "req-456")
+				}
+				if b, _ := io.ReadAll(resp.Body); len(b) != 0 {
+					t.Fatalf("body is readable (%q), want it drained", b)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package dataAccess
+
+import (
+	"fmt"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// SnapshotDelta computes the per-node change of a float variable
+// between two snapshots, returning b - a for every node.
+//
+// This is typically used to compare cumulative readings, such as
+// meter values, captured by GetSnapshot at two timestamps.
+//
+// Nodes present in only one of the snapshots are skipped. A value
+// that cannot be decoded as a float in either snapshot fails the
+// whole computation with errors.ErrInvalidValueType.
+func SnapshotDelta(a, b *GetSnapshotResponse) (map[string]float64, error) {
+	if a == nil || b == nil {
+		return nil, &errors.AnedyaError{
+			Message: "snapshots cannot be nil",
+			Err:     errors.ErrInputRequired,
+		}
+	}
+
+	delta := make(map[string]float64)
+	for nodeID, before := range a.Data {
+		after, ok := b.Data[nodeID]
+		if !ok {
+			continue
+		}
+
+		from, ok := before.AsFloat()
+		if !ok {
+			return nil, &errors.AnedyaError{
+				Message: fmt.Sprintf("value of node %s in first snapshot is not a float", nodeID),
+				Err:     errors.ErrInvalidValueType,
+			}
+		}
+
+		to, ok := after.AsFloat()
+		if !ok {
+			return nil, &errors.AnedyaError{
+				Message: fmt.Sprintf("value of node %s in second snapshot is not a float", nodeID),
+				Err:     errors.ErrInvalidValueType,
+			}
+		}
+
+		delta[nodeID] = to - from
+	}
+
+	return delta, nil
+}
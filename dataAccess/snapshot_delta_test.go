@@ -0,0 +1,84 @@
+package dataAccess_test
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/dataAccess"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// snapshot builds a GetSnapshotResponse from raw JSON values keyed
+// by node ID.
+func snapshot(values map[string]string) *dataAccess.GetSnapshotResponse {
+	data := make(map[string]dataAccess.DataPoint, len(values))
+	for id, v := range values {
+		data[id] = dataAccess.DataPoint{Value: json.RawMessage(v)}
+	}
+	return &dataAccess.GetSnapshotResponse{Success: true, Data: data, Count: len(data)}
+}
+
+func TestSnapshotDelta(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    *dataAccess.GetSnapshotResponse
+		want    map[string]float64
+		wantErr error
+	}{
+		{
+			name: "aligned nodes",
+			a:    snapshot(map[string]string{"n1": "10", "n2": "2.5"}),
+			b:    snapshot(map[string]string{"n1": "15", "n2": "1"}),
+			want: map[string]float64{"n1": 5, "n2": -1.5},
+		},
+		{
+			name: "node missing from one snapshot is skipped",
+			a:    snapshot(map[string]string{"n1": "10", "n2": "20"}),
+			b:    snapshot(map[string]string{"n1": "11", "n3": "30"}),
+			want: map[string]float64{"n1": 1},
+		},
+		{
+			name: "no common nodes",
+			a:    snapshot(map[string]string{"n1": "10"}),
+			b:    snapshot(map[string]string{"n2": "10"}),
+			want: map[string]float64{},
+		},
+		{
+			name:    "non-float value in first snapshot",
+			a:       snapshot(map[string]string{"n1": `{"lat":1,"long":2}`}),
+			b:       snapshot(map[string]string{"n1": "1"}),
+			wantErr: errors.ErrInvalidValueType,
+		},
+		{
+			name:    "non-float value in second snapshot",
+			a:       snapshot(map[string]string{"n1": "1"}),
+			b:       snapshot(map[string]string{"n1": `"on"`}),
+			wantErr: errors.ErrInvalidValueType,
+		},
+		{
+			name:    "nil snapshot",
+			a:       snapshot(map[string]string{"n1": "1"}),
+			wantErr: errors.ErrInputRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dataAccess.SnapshotDelta(tt.a, tt.b)
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("SnapshotDelta() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SnapshotDelta() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("SnapshotDelta() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -34,9 +34,46 @@ var (
 	// the API response body.
 	ErrResponseDecodeFailed = errors.New("response decode failed")
 
+	// ErrResponseRejected indicates that a response interceptor
+	// rejected the HTTP response.
+	ErrResponseRejected = errors.New("response rejected")
+
 	// ErrUnauthorized indicates an authentication or authorization failure.
 	ErrUnauthorized = errors.New("unauthorized")
 
+	// ErrCertificatePinMismatch indicates that the server certificate
+	// did not match the pinned fingerprint.
+	ErrCertificatePinMismatch = errors.New("certificate pin mismatch")
+
 	// ErrUnknown indicates an unclassified or unexpected error.
 	ErrUnknown = errors.New("unknown error")
+
+	// ErrNotFound indicates that a requested resource does not exist.
+	//
+	// Resource-specific not-found errors such as ErrNodeNotFound or
+	// ErrVariableNotFound wrap ErrNotFound, so
+	// errors.Is(err, ErrNotFound) matches any of them.
+	ErrNotFound = errors.New("resource not found")
 )
+
+// notFoundError is a resource-specific sentinel error that
+// unwraps to ErrNotFound.
+type notFoundError struct {
+	msg string
+}
+
+// Error implements the error interface.
+func (e *notFoundError) Error() string {
+	return e.msg
+}
+
+// Unwrap allows errors.Is to match ErrNotFound.
+func (e *notFoundError) Unwrap() error {
+	return ErrNotFound
+}
+
+// newNotFoundError creates a sentinel error with the given message
+// that wraps ErrNotFound.
+func newNotFoundError(msg string) error {
+	return &notFoundError{msg: msg}
+}
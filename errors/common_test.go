@@ -0,0 +1,71 @@
+package errors_test
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestNotFoundErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "ErrNodeNotFound", err: errors.ErrNodeNotFound},
+		{name: "ErrVariableNotFound", err: errors.ErrVariableNotFound},
+		{name: "ErrNodeChildNotFound", err: errors.ErrNodeChildNotFound},
+		{name: "ErrNodeDeviceNotFound", err: errors.ErrNodeDeviceNotFound},
+		{name: "ErrAccessTokenNotFound", err: errors.ErrAccessTokenNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !stderrors.Is(tt.err, errors.ErrNotFound) {
+				t.Fatalf("errors.Is(%v, ErrNotFound) = false, want true", tt.err)
+			}
+
+			// the specific sentinel must still be distinguishable
+			wrapped := fmt.Errorf("lookup: %w", tt.err)
+			if !stderrors.Is(wrapped, tt.err) {
+				t.Fatalf("errors.Is(wrapped, %v) = false, want true", tt.err)
+			}
+			if !stderrors.Is(wrapped, errors.ErrNotFound) {
+				t.Fatalf("errors.Is(wrapped, ErrNotFound) = false, want true")
+			}
+		})
+	}
+}
+
+func TestNotFoundFromReasonCode(t *testing.T) {
+	tests := []struct {
+		code errors.ReasonCode
+		want error
+	}{
+		{code: errors.ReasonNodeNotFound, want: errors.ErrNodeNotFound},
+		{code: errors.ReasonNodeChildNotFound, want: errors.ErrNodeChildNotFound},
+		{code: errors.ReasonNodeDeviceNotFound, want: errors.ErrNodeDeviceNotFound},
+		{code: errors.ReasonDataVariableNotFound, want: errors.ErrVariableNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.code), func(t *testing.T) {
+			err := errors.GetError(string(tt.code), "not found")
+			if !stderrors.Is(err, tt.want) {
+				t.Fatalf("GetError(%q) = %v, want %v", tt.code, err, tt.want)
+			}
+			if !stderrors.Is(err, errors.ErrNotFound) {
+				t.Fatalf("GetError(%q) does not match ErrNotFound", tt.code)
+			}
+		})
+	}
+}
+
+func TestNotFoundExcludesOtherErrors(t *testing.T) {
+	for _, err := range []error{errors.ErrInvalidInput, errors.ErrUnknown, errors.ErrNodeChildExists} {
+		if stderrors.Is(err, errors.ErrNotFound) {
+			t.Errorf("errors.Is(%v, ErrNotFound) = true, want false", err)
+		}
+	}
+}
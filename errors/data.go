@@ -10,10 +10,12 @@ var (
 	ErrInvalidTimeRange = errors.New("invalid from/to time range")
 	ErrInvalidOrder     = errors.New("invalid order value")
 	ErrInvalidTimestamp = errors.New("invalid timestamp")
+	ErrInvalidValueType = errors.New("invalid value type")
+	ErrInvalidGeoValue  = errors.New("invalid geo coordinate")
 )
 
 // Data API – API level errors
 var (
-	ErrVariableNotFound = errors.New("variable not found")
+	ErrVariableNotFound = newNotFoundError("variable not found")
 	ErrInvalidNodeID    = errors.New("invalid node id")
 )
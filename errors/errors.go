@@ -16,6 +16,17 @@ type AnedyaError struct {
 
 	// Err is the underlying sentinel error.
 	Err error
+
+	// StatusCode is the HTTP status code of the API response,
+	// or zero when the error occurred before a response was received.
+	StatusCode int
+
+	// RawBody is the raw API response body, if one was received.
+	RawBody []byte
+
+	// ReasonCode is the reason code returned by the API, or empty
+	// when the error did not originate from an API response.
+	ReasonCode ReasonCode
 }
 
 // Error implements the error interface.
@@ -23,51 +34,63 @@ func (e *AnedyaError) Error() string {
 	return fmt.Sprintf("anedya api error: %s: %v", e.Message, e.Err)
 }
 
-// Unwrap allows errors.Is to work with AnedyaError.
+// Unwrap allows errors.Is and errors.As to see through AnedyaError
+// to the wrapped sentinel error.
 func (e *AnedyaError) Unwrap() error {
 	return e.Err
 }
 
 // codeMap maps API reason codes to SDK sentinel errors.
-var codeMap = map[string]error{
+var codeMap = map[ReasonCode]error{
 	// generic errors
-	"generic::malformedrequest": ErrInvalidInput,
+	ReasonMalformedRequest: ErrInvalidInput,
 
 	// node errors
-	"node::devidexists":          ErrNodeDeviceIDExists,
-	"node::childexists":          ErrNodeChildExists,
-	"node::uniquealiasviolation": ErrNodeUniqueAliasViolation,
-	"node::uniquechildviolation": ErrNodeUniqueChildViolation,
-	"node::childnotfound":        ErrNodeChildNotFound,
-	"node::invalidparentid":      ErrNodeInvalidParentID,
-	"node::invalidchildid":       ErrNodeInvalidChildID,
-	"node::nodenotfound":         ErrNodeNotFound,
-	"node::devicenotfound":       ErrNodeDeviceNotFound,
-	"node::invaliduuid":          ErrNodeInvalidUUID,
+	ReasonNodeDeviceIDExists:       ErrNodeDeviceIDExists,
+	ReasonNodeChildExists:          ErrNodeChildExists,
+	ReasonNodeUniqueAliasViolation: ErrNodeUniqueAliasViolation,
+	ReasonNodeUniqueChildViolation: ErrNodeUniqueChildViolation,
+	ReasonNodeChildNotFound:        ErrNodeChildNotFound,
+	ReasonNodeInvalidParentID:      ErrNodeInvalidParentID,
+	ReasonNodeInvalidChildID:       ErrNodeInvalidChildID,
+	ReasonNodeNotFound:             ErrNodeNotFound,
+	ReasonNodeDeviceNotFound:       ErrNodeDeviceNotFound,
+	ReasonNodeInvalidUUID:          ErrNodeInvalidUUID,
 
 	// Data API errors
-	"data::variablenotfound": ErrVariableNotFound,
-	"data::invalidnodeid":    ErrInvalidNodeID,
+	ReasonDataVariableNotFound: ErrVariableNotFound,
+	ReasonDataInvalidNodeID:    ErrInvalidNodeID,
 
 	// variable errors
-	"variable::namerequired":     ErrVariableNameRequired,
-	"variable::variablerequired": ErrVariableRequired,
-	"variable::typerequired":     ErrVariableTypeRequired,
+	ReasonVariableNameRequired:     ErrVariableNameRequired,
+	ReasonVariableVariableRequired: ErrVariableRequired,
+	ReasonVariableTypeRequired:     ErrVariableTypeRequired,
 
 	// accesstoken errors
-	"fa::invalidexpiry": ErrExpiryRequried,
-	"fa::tokennofound":  ErrInvalidToken,
+	ReasonTokenInvalidExpiry: ErrExpiryRequried,
+	ReasonTokenNotFound:      ErrInvalidToken,
 }
 
 // GetError converts an API reason code and message into an AnedyaError.
 func GetError(code, message string) error {
-	sentinel, ok := codeMap[code]
+	sentinel, ok := codeMap[ReasonCode(code)]
 	if !ok {
 		sentinel = ErrUnknown
 	}
 
 	return &AnedyaError{
-		Message: message,
-		Err:     sentinel,
+		Message:    message,
+		Err:        sentinel,
+		ReasonCode: ReasonCode(code),
 	}
 }
+
+// GetErrorWithResponse converts an API reason code and message into an
+// AnedyaError, recording the HTTP status code and raw response body
+// for debugging.
+func GetErrorWithResponse(code, message string, statusCode int, rawBody []byte) error {
+	err := GetError(code, message).(*AnedyaError)
+	err.StatusCode = statusCode
+	err.RawBody = rawBody
+	return err
+}
@@ -0,0 +1,108 @@
+package errors_test
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestAnedyaErrorUnwrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		sentinel error
+		wantCode errors.ReasonCode
+	}{
+		{
+			name:     "sentinel",
+			err:      &errors.AnedyaError{Message: "node not found", Err: errors.ErrNodeNotFound},
+			sentinel: errors.ErrNodeNotFound,
+		},
+		{
+			name:     "wrapped by caller",
+			err:      fmt.Errorf("deleting node: %w", &errors.AnedyaError{Message: "node not found", Err: errors.ErrNodeNotFound}),
+			sentinel: errors.ErrNodeNotFound,
+		},
+		{
+			name:     "from reason code",
+			err:      errors.GetErrorWithResponse(string(errors.ReasonNodeNotFound), "node not found", http.StatusNotFound, nil),
+			sentinel: errors.ErrNodeNotFound,
+			wantCode: errors.ReasonNodeNotFound,
+		},
+		{
+			name:     "unknown reason code",
+			err:      errors.GetError("unknown::code", "boom"),
+			sentinel: errors.ErrUnknown,
+			wantCode: "unknown::code",
+		},
+		{
+			name:     "field error",
+			err:      errors.NewFieldError("nodes[0]", "", "must not be empty", errors.ErrInvalidNode),
+			sentinel: errors.ErrInvalidNode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !stderrors.Is(tt.err, tt.sentinel) {
+				t.Fatalf("errors.Is(%v, %v) = false, want true", tt.err, tt.sentinel)
+			}
+
+			var apiErr *errors.AnedyaError
+			if !stderrors.As(tt.err, &apiErr) {
+				t.Fatalf("errors.As(%v, *AnedyaError) = false, want true", tt.err)
+			}
+			if apiErr.ReasonCode != tt.wantCode {
+				t.Fatalf("ReasonCode = %q, want %q", apiErr.ReasonCode, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestFieldErrorAs(t *testing.T) {
+	err := fmt.Errorf("validating: %w", errors.NewFieldError("limit", 0, "must be positive", errors.ErrInvalidInput))
+
+	var fe *errors.FieldError
+	if !stderrors.As(err, &fe) {
+		t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+	}
+	if fe.Field != "limit" || fe.Value != 0 {
+		t.Fatalf("FieldError = %+v, want field limit with value 0", fe)
+	}
+}
+
+func TestAnedyaErrorFromAPI(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+		return nil, &errors.AnedyaError{
+			Message:    "node not found",
+			ReasonCode: errors.ReasonNodeNotFound,
+			StatusCode: http.StatusNotFound,
+		}
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	_, err := client.NodeManagement.GetNodeDetails(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+
+	if !stderrors.Is(err, errors.ErrNodeNotFound) {
+		t.Fatalf("errors.Is(%v, ErrNodeNotFound) = false, want true", err)
+	}
+
+	var apiErr *errors.AnedyaError
+	if !stderrors.As(err, &apiErr) {
+		t.Fatalf("errors.As(%v, *AnedyaError) = false, want true", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.ReasonCode != errors.ReasonNodeNotFound {
+		t.Fatalf("AnedyaError = %+v, want status 404 with reason %q", apiErr, errors.ReasonNodeNotFound)
+	}
+}
@@ -0,0 +1,52 @@
+package errors
+
+import "fmt"
+
+// FieldError describes a request field that failed validation.
+//
+// It is carried as the Err of an *AnedyaError and unwraps to the
+// sentinel error of the failed check, so errors.Is keeps working
+// while errors.As exposes the offending field:
+//
+//	var fe *errors.FieldError
+//	if errors.As(err, &fe) {
+//		log.Printf("bad %s = %v: %s", fe.Field, fe.Value, fe.Reason)
+//	}
+type FieldError struct {
+	// Field is the JSON name of the offending field, with an index
+	// for slice elements, for example "nodes[2]".
+	Field string
+
+	// Value is the rejected value.
+	Value interface{}
+
+	// Reason explains why the value was rejected.
+	Reason string
+
+	// Err is the sentinel error of the failed check.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v: %s %s (got %v)", e.Err, e.Field, e.Reason, e.Value)
+}
+
+// Unwrap allows errors.Is to match the sentinel error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// NewFieldError returns an *AnedyaError for a request field that
+// failed validation, wrapping a FieldError around sentinel.
+func NewFieldError(field string, value interface{}, reason string, sentinel error) *AnedyaError {
+	return &AnedyaError{
+		Message: fmt.Sprintf("invalid %s", field),
+		Err: &FieldError{
+			Field:  field,
+			Value:  value,
+			Reason: reason,
+			Err:    sentinel,
+		},
+	}
+}
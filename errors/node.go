@@ -19,11 +19,15 @@ var (
 
 	// ErrNodeNotFound is returned when node details
 	// are not found.
-	ErrNodeNotFound = errors.New("node not found")
+	ErrNodeNotFound = newNotFoundError("node not found")
 
 	// ErrNodeInvalidUUID is returned when node ID
 	// is not a valid UUID.
 	ErrNodeInvalidUUID = errors.New("node invalid uuid")
+
+	// ErrNodeIDNotSet is returned when a Node wrapper
+	// method is called on a node without a NodeId.
+	ErrNodeIDNotSet = errors.New("node id not set")
 )
 
 // ----------------------------------------------------
@@ -51,6 +55,10 @@ var (
 	// ErrNodeListInvalidOrder is returned when order
 	// is neither 'asc' nor 'desc'.
 	ErrNodeListInvalidOrder = errors.New("invalid order")
+
+	// ErrNodeTagKeyRequired is returned when a tag
+	// search is made without a tag key.
+	ErrNodeTagKeyRequired = errors.New("tag key required")
 )
 
 // ----------------------------------------------------
@@ -107,7 +115,7 @@ var (
 	// childNode is missing.
 	ErrRemoveChildNodeChildIDRequired = errors.New("child id required")
 
-	ErrNodeChildNotFound   = errors.New("no such child node associated with the parent node")
+	ErrNodeChildNotFound   = newNotFoundError("no such child node associated with the parent node")
 	ErrNodeInvalidParentID = errors.New("invalid parent node ID")
 	ErrNodeInvalidChildID  = errors.New("invalid child node ID")
 )
@@ -154,6 +162,16 @@ var (
 	ErrGetConnectionKeyNodeIDRequired = errors.New("node id required")
 )
 
+// ----------------------------------------------------
+// RegenerateConnectionKey validation errors
+// ----------------------------------------------------
+
+var (
+	// ErrRegenerateConnectionKeyNodeIDRequired is returned when
+	// nodeId is missing.
+	ErrRegenerateConnectionKeyNodeIDRequired = errors.New("node id required")
+)
+
 // ----------------------------------------------------
 // UpdateNode validation errors
 // ----------------------------------------------------
@@ -170,6 +188,22 @@ var (
 	// ErrUpdateNodeEmptyUpdates is returned when
 	// no updates are provided.
 	ErrUpdateNodeEmptyUpdates = errors.New("no updates provided")
+
+	// ErrUpdateNodeTypeRequired is returned when
+	// an update operation has no type.
+	ErrUpdateNodeTypeRequired = errors.New("update type required")
+
+	// ErrUpdateNodeInvalidType is returned when
+	// an update operation has an unsupported type.
+	ErrUpdateNodeInvalidType = errors.New("invalid update type")
+
+	// ErrUpdateNodeTagRequired is returned when
+	// a tag or delete-tag update has no tag key.
+	ErrUpdateNodeTagRequired = errors.New("update tag required")
+
+	// ErrUpdateNodeValueRequired is returned when
+	// a name or description update has no value.
+	ErrUpdateNodeValueRequired = errors.New("update value required")
 )
 
 // ----------------------------------------------------
@@ -189,7 +223,25 @@ var (
 	// deviceId is missing.
 	ErrAuthorizeDeviceDeviceIDRequired = errors.New("device id required")
 
-	ErrNodeDeviceNotFound = errors.New("node device not found")
+	ErrNodeDeviceNotFound = newNotFoundError("node device not found")
+)
+
+// ----------------------------------------------------
+// DeauthorizeDevice validation errors
+// ----------------------------------------------------
+
+var (
+	// ErrDeauthorizeDeviceRequestNil is returned when the
+	// DeauthorizeDevice request is nil.
+	ErrDeauthorizeDeviceRequestNil = errors.New("request is nil")
+
+	// ErrDeauthorizeDeviceNodeIDRequired is returned when
+	// nodeId is missing.
+	ErrDeauthorizeDeviceNodeIDRequired = errors.New("node id required")
+
+	// ErrDeauthorizeDeviceDeviceIDRequired is returned when
+	// deviceId is missing.
+	ErrDeauthorizeDeviceDeviceIDRequired = errors.New("device id required")
 )
 
 // ----------------------------------------------------
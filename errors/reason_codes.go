@@ -0,0 +1,54 @@
+package errors
+
+// ReasonCode is a machine-readable error code returned by the
+// Anedya API in the reasonCode field of error responses.
+//
+// Callers can branch on the code of an *AnedyaError without
+// matching on messages:
+//
+//	var apiErr *errors.AnedyaError
+//	if errors.As(err, &apiErr) && apiErr.ReasonCode == errors.ReasonNodeNotFound {
+//		// ...
+//	}
+//
+// Codes not listed here are still recorded on AnedyaError as
+// returned by the server.
+type ReasonCode string
+
+// Generic reason codes.
+const (
+	ReasonMalformedRequest ReasonCode = "generic::malformedrequest"
+)
+
+// Node reason codes.
+const (
+	ReasonNodeDeviceIDExists       ReasonCode = "node::devidexists"
+	ReasonNodeChildExists          ReasonCode = "node::childexists"
+	ReasonNodeUniqueAliasViolation ReasonCode = "node::uniquealiasviolation"
+	ReasonNodeUniqueChildViolation ReasonCode = "node::uniquechildviolation"
+	ReasonNodeChildNotFound        ReasonCode = "node::childnotfound"
+	ReasonNodeInvalidParentID      ReasonCode = "node::invalidparentid"
+	ReasonNodeInvalidChildID       ReasonCode = "node::invalidchildid"
+	ReasonNodeNotFound             ReasonCode = "node::nodenotfound"
+	ReasonNodeDeviceNotFound       ReasonCode = "node::devicenotfound"
+	ReasonNodeInvalidUUID          ReasonCode = "node::invaliduuid"
+)
+
+// Data reason codes.
+const (
+	ReasonDataVariableNotFound ReasonCode = "data::variablenotfound"
+	ReasonDataInvalidNodeID    ReasonCode = "data::invalidnodeid"
+)
+
+// Variable reason codes.
+const (
+	ReasonVariableNameRequired     ReasonCode = "variable::namerequired"
+	ReasonVariableVariableRequired ReasonCode = "variable::variablerequired"
+	ReasonVariableTypeRequired     ReasonCode = "variable::typerequired"
+)
+
+// Access token reason codes.
+const (
+	ReasonTokenInvalidExpiry ReasonCode = "fa::invalidexpiry"
+	ReasonTokenNotFound      ReasonCode = "fa::tokennofound"
+)
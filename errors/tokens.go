@@ -21,4 +21,8 @@ var (
 
 	// ErrInvalidToken indicates that the input tokenId is invalid
 	ErrInvalidToken = errors.New("TokenId is invalid")
+
+	// ErrAccessTokenNotFound indicates that no access token
+	// exists with the given tokenId.
+	ErrAccessTokenNotFound = newNotFoundError("access token not found")
 )
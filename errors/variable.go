@@ -24,4 +24,8 @@ var (
 	//   - geo
 	//   - float
 	ErrVariableTypeRequired = errors.New("variable type is required")
+
+	// ErrVariableNoChanges is returned when an update request
+	// does not change any variable field.
+	ErrVariableNoChanges = errors.New("no variable fields to update")
 )
@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -99,7 +101,13 @@ func (nm *NodeManagement) AddChildNode(ctx context.Context, req *AddChildNodeReq
 	}
 
 	// build API URL
-	url := fmt.Sprintf("%s/v1/node/child/add", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeChildAdd)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build AddChildNode request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// convert request to JSON
 	body, err := json.Marshal(req)
@@ -124,30 +132,50 @@ func (nm *NodeManagement) AddChildNode(ctx context.Context, req *AddChildNodeReq
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// send HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return &errors.AnedyaError{
 			Message: "failed to execute AddChildNode request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read AddChildNode response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// an empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, respBody) {
+		return nil
+	}
+
 	// decode API response
 	var apiResp AddChildNodeResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return &errors.AnedyaError{
-			Message: "failed to decode AddChildNode response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode AddChildNode response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// handle HTTP or API level errors
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// success
 	return nil
 }
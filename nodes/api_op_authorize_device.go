@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -82,7 +84,13 @@ func (nm *NodeManagement) AuthorizeDevice(
 	}
 
 	// Construct API endpoint URL
-	url := fmt.Sprintf("%s/v1/node/authorize", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeAuthorize)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build AuthorizeDevice request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Marshal request payload to JSON
 	body, err := json.Marshal(req)
@@ -107,29 +115,49 @@ func (nm *NodeManagement) AuthorizeDevice(
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return &errors.AnedyaError{
 			Message: "failed to execute AuthorizeDevice request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read AuthorizeDevice response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// An empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, respBody) {
+		return nil
+	}
+
 	// Decode response JSON
 	var apiResp AuthorizeDeviceResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return &errors.AnedyaError{
-			Message: "failed to decode AuthorizeDevice response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode AuthorizeDevice response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// handle HTTP or API level error
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	return nil
 }
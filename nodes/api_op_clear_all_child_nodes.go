@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -70,7 +72,13 @@ func (nm *NodeManagement) ClearChildNodes(
 	}
 
 	// Construct API endpoint URL
-	url := fmt.Sprintf("%s/v1/node/child/clear", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeChildClear)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build ClearChildNodes request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Marshal request payload to JSON
 	body, err := json.Marshal(req)
@@ -95,29 +103,49 @@ func (nm *NodeManagement) ClearChildNodes(
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return &errors.AnedyaError{
 			Message: "failed to execute ClearChildNodes request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read ClearChildNodes response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// An empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, respBody) {
+		return nil
+	}
+
 	// Decode response JSON
 	var apiResp ClearChildNodesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return &errors.AnedyaError{
-			Message: "failed to decode ClearChildNodes response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode ClearChildNodes response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// handle HTTP or API level error
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	return nil
 }
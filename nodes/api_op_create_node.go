@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -84,7 +86,13 @@ func (nm *NodeManagement) CreateNode(
 	}
 
 	// Construct API endpoint URL
-	url := fmt.Sprintf("%s/v1/node/create", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeCreate)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build CreateNode request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Build HTTP POST request with context
 	httpReq, err := http.NewRequestWithContext(
@@ -100,30 +108,45 @@ func (nm *NodeManagement) CreateNode(
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return nil, &errors.AnedyaError{
 			Message: "failed to execute CreateNode request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to read CreateNode response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
 	// Decode response JSON
 	var apiResp CreateNodeResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return nil, &errors.AnedyaError{
-			Message: "failed to decode CreateNode response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode CreateNode response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// Check for any error (HTTP or API-level)
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// Success: return the newly created Node
 	return &Node{
 		NodeId:          apiResp.NodeId,
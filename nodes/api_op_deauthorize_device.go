@@ -0,0 +1,166 @@
+package nodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// DeauthorizeDeviceRequest represents the payload sent to the Deauthorize Device API.
+// It contains identifiers for the node and the device whose binding is being revoked.
+type DeauthorizeDeviceRequest struct {
+	// NodeID is the unique identifier of the node
+	// the device is currently bound to.
+	NodeID string `json:"nodeid"`
+
+	// DeviceID is the unique identifier of the device
+	// whose authorization is being revoked.
+	DeviceID string `json:"deviceid"`
+}
+
+// DeauthorizeDeviceResponse represents the response returned by the Deauthorize Device API.
+type DeauthorizeDeviceResponse struct {
+	// Success indicates whether the device deauthorization request was successful.
+	Success bool `json:"success"`
+
+	// Error contains a human-readable error message returned by the API
+	// when Success is false.
+	Error string `json:"error"`
+
+	// ReasonCode is a machine-readable error code used for SDK error mapping.
+	ReasonCode string `json:"reasonCode,omitempty"`
+}
+
+// DeauthorizeDevice revokes the binding between a device and a node in the
+// Anedya platform, the inverse of AuthorizeDevice.
+//
+// This method performs the following operations:
+//  1. Validates the request payload and mandatory fields (NodeID and DeviceID).
+//  2. Marshals the request payload into JSON.
+//  3. Constructs an HTTP POST request to the Deauthorize Device API endpoint.
+//  4. Executes the HTTP request using the NodeManagement's HTTP client.
+//  5. Decodes the API response into DeauthorizeDeviceResponse.
+//  6. Checks API response status and maps API errors into structured SDK errors.
+//
+// Parameters:
+//   - ctx: Context for controlling request cancellation and timeout.
+//   - req: Pointer to DeauthorizeDeviceRequest containing node and device identifiers.
+//
+// Returns:
+//   - error: Returns nil if the binding is revoked, otherwise returns
+//     *errors.AnedyaError if validation, network, or API errors occur.
+//     When the device is not bound to the node, the error wraps
+//     errors.ErrNodeDeviceNotFound.
+func (nm *NodeManagement) DeauthorizeDevice(
+	ctx context.Context,
+	req *DeauthorizeDeviceRequest,
+) error {
+
+	// Validate request object
+	if req == nil {
+		return &errors.AnedyaError{
+			Message: "deauthorize device request cannot be nil",
+			Err:     errors.ErrDeauthorizeDeviceRequestNil,
+		}
+	}
+
+	// Validate NodeID
+	if req.NodeID == "" {
+		return &errors.AnedyaError{
+			Message: "nodeId is required to deauthorize device",
+			Err:     errors.ErrDeauthorizeDeviceNodeIDRequired,
+		}
+	}
+
+	// Validate DeviceID
+	if req.DeviceID == "" {
+		return &errors.AnedyaError{
+			Message: "deviceId is required to deauthorize device",
+			Err:     errors.ErrDeauthorizeDeviceDeviceIDRequired,
+		}
+	}
+
+	// Construct API endpoint URL
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeDeauthorize)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build DeauthorizeDevice request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	// Marshal request payload to JSON
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to encode DeauthorizeDevice request",
+			Err:     errors.ErrRequestEncodeFailed,
+		}
+	}
+
+	// Build HTTP POST request with context
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		url,
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build DeauthorizeDevice request",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	common.SetJSONHeaders(httpReq)
+
+	// Execute HTTP request
+	resp, err := nm.httpClient.Do(httpReq)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to execute DeauthorizeDevice request",
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
+		}
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read DeauthorizeDevice response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// An empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, respBody) {
+		return nil
+	}
+
+	// Decode response JSON
+	var apiResp DeauthorizeDeviceResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return &errors.AnedyaError{
+			Message:    "failed to decode DeauthorizeDevice response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
+		}
+	}
+
+	// handle HTTP or API level error
+	if resp.StatusCode != http.StatusOK || !apiResp.Success {
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
+	}
+
+	common.CaptureDecoded(resp, respBody)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package nodes_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestDeauthorizeDevice(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *nodes.DeauthorizeDeviceRequest
+		status  int
+		body    string
+		wantErr error
+		// wantSent reports whether the request reaches the server.
+		wantSent bool
+	}{
+		{name: "nil request", wantErr: errors.ErrDeauthorizeDeviceRequestNil},
+		{name: "missing node ID", req: &nodes.DeauthorizeDeviceRequest{DeviceID: "d1"}, wantErr: errors.ErrDeauthorizeDeviceNodeIDRequired},
+		{name: "missing device ID", req: &nodes.DeauthorizeDeviceRequest{NodeID: "n1"}, wantErr: errors.ErrDeauthorizeDeviceDeviceIDRequired},
+		{
+			name:     "empty body success",
+			req:      &nodes.DeauthorizeDeviceRequest{NodeID: "n1", DeviceID: "d1"},
+			status:   http.StatusOK,
+			wantSent: true,
+		},
+		{
+			name:     "JSON success",
+			req:      &nodes.DeauthorizeDeviceRequest{NodeID: "n1", DeviceID: "d1"},
+			status:   http.StatusOK,
+			body:     `{"success":true}`,
+			wantSent: true,
+		},
+		{
+			name:     "device not found",
+			req:      &nodes.DeauthorizeDeviceRequest{NodeID: "n1", DeviceID: "d1"},
+			status:   http.StatusNotFound,
+			body:     `{"success":false,"error":"device not found","reasonCode":"` + string(errors.ReasonNodeDeviceNotFound) + `"}`,
+			wantErr:  errors.ErrNodeDeviceNotFound,
+			wantSent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var sent *nodes.DeauthorizeDeviceRequest
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDeauthorize, func(w http.ResponseWriter, r *http.Request) {
+				sent = new(nodes.DeauthorizeDeviceRequest)
+				json.NewDecoder(r.Body).Decode(sent)
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			err := client.NodeManagement.DeauthorizeDevice(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("DeauthorizeDevice() error = %v", err)
+			}
+			if tt.wantErr != nil && !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("DeauthorizeDevice() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !tt.wantSent {
+				if sent != nil {
+					t.Fatal("invalid request reached the server")
+				}
+				return
+			}
+			if sent == nil || *sent != *tt.req {
+				t.Fatalf("server received %+v, want %+v", sent, tt.req)
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -74,7 +76,13 @@ func (nm *NodeManagement) GetConnectionKey(
 	}
 
 	// Construct API endpoint URL
-	url := fmt.Sprintf("%s/v1/node/getConnectionKey", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeGetConnectionKey)
+	if err != nil {
+		return "", &errors.AnedyaError{
+			Message: "failed to build GetConnectionKey request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Marshal request payload to JSON
 	body, err := json.Marshal(req)
@@ -94,30 +102,45 @@ func (nm *NodeManagement) GetConnectionKey(
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return "", &errors.AnedyaError{
 			Message: "failed to execute GetConnectionKey request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", &errors.AnedyaError{
+			Message: "failed to read GetConnectionKey response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
 	// Decode response JSON
 	var apiResp GetConnectionKeyResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return "", &errors.AnedyaError{
-			Message: "failed to decode GetConnectionKey response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode GetConnectionKey response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// Handle HTTP or API-level errors
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return "", errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return "", errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// Success: return the connection key
 	return apiResp.ConnectionKey, nil
 }
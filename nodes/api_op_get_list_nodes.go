@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -74,10 +76,25 @@ func (nm *NodeManagement) GetNodeList(
 	ctx context.Context,
 	req *GetNodeListRequest,
 ) (*GetNodeListResponse, error) {
+	result, _, err := nm.GetNodeListRaw(ctx, req)
+	return result, err
+}
+
+// GetNodeListRaw behaves like GetNodeList but also returns the underlying
+// *http.Response, so callers can inspect the status code and headers
+// such as rate-limit counters or request IDs.
+//
+// The response body has already been consumed and closed and must
+// not be read. The response is nil when the request never reached
+// the server, and is returned alongside the error otherwise.
+func (nm *NodeManagement) GetNodeListRaw(
+	ctx context.Context,
+	req *GetNodeListRequest,
+) (*GetNodeListResponse, *http.Response, error) {
 
 	// Validate request object
 	if req == nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "get node list request cannot be nil",
 			Err:     errors.ErrNodeListRequestNil,
 		}
@@ -85,7 +102,7 @@ func (nm *NodeManagement) GetNodeList(
 
 	// Validate Limit
 	if req.Limit <= 0 || req.Limit > 1000 {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "limit must be between 1 and 1000",
 			Err:     errors.ErrNodeListInvalidLimit,
 		}
@@ -93,7 +110,7 @@ func (nm *NodeManagement) GetNodeList(
 
 	// Validate Order
 	if req.Order != "asc" && req.Order != "desc" {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "order must be either 'asc' or 'desc'",
 			Err:     errors.ErrNodeListInvalidOrder,
 		}
@@ -102,55 +119,79 @@ func (nm *NodeManagement) GetNodeList(
 	// Marshal request payload to JSON
 	body, err := json.Marshal(req)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to encode GetNodeList request",
 			Err:     errors.ErrRequestEncodeFailed,
 		}
 	}
 
 	// Construct API endpoint URL
-	url := fmt.Sprintf("%s/v1/node/list", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeList)
+	if err != nil {
+		return nil, nil, &errors.AnedyaError{
+			Message: "failed to build GetNodeList request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Build HTTP POST request with context
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to build GetNodeList request",
 			Err:     errors.ErrRequestBuildFailed,
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to execute GetNodeList request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message: "failed to read GetNodeList response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// The body has been consumed; hide it from callers of the Raw variant.
+	resp.Body = http.NoBody
+
 	// Decode API response
 	var apiResp GetNodeListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, &errors.AnedyaError{
-			Message: "failed to decode GetNodeList response",
-			Err:     errors.ErrResponseDecodeFailed,
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message:    "failed to decode GetNodeList response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// HTTP-level error
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, resp, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
 	// API-level error handling
 	if !apiResp.Success {
-		sdkErr := errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		sdkErr := errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 		// Return any other API errors
-		return nil, sdkErr
+		return nil, resp, sdkErr
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// Success
-	return &apiResp, nil
+	return &apiResp, resp, nil
 }
@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -56,10 +58,25 @@ func (nm *NodeManagement) GetNodeDetails(
 	ctx context.Context,
 	req *GetNodeDetailsRequest,
 ) (map[string]Node, error) {
+	result, _, err := nm.GetNodeDetailsRaw(ctx, req)
+	return result, err
+}
+
+// GetNodeDetailsRaw behaves like GetNodeDetails but also returns the underlying
+// *http.Response, so callers can inspect the status code and headers
+// such as rate-limit counters or request IDs.
+//
+// The response body has already been consumed and closed and must
+// not be read. The response is nil when the request never reached
+// the server, and is returned alongside the error otherwise.
+func (nm *NodeManagement) GetNodeDetailsRaw(
+	ctx context.Context,
+	req *GetNodeDetailsRequest,
+) (map[string]Node, *http.Response, error) {
 
 	// Validate request
 	if req == nil || len(req.Nodes) == 0 {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "node list cannot be empty",
 			Err:     errors.ErrNodeDetailsRequestNil,
 		}
@@ -68,48 +85,72 @@ func (nm *NodeManagement) GetNodeDetails(
 	// Marshal request payload to JSON
 	body, err := json.Marshal(req)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to encode GetNodeDetails request",
 			Err:     errors.ErrRequestEncodeFailed,
 		}
 	}
 
 	// Construct API endpoint URL
-	url := fmt.Sprintf("%s/v1/node/details", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeDetails)
+	if err != nil {
+		return nil, nil, &errors.AnedyaError{
+			Message: "failed to build GetNodeDetails request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Build HTTP POST request with context
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to build GetNodeDetails request",
 			Err:     errors.ErrRequestBuildFailed,
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, &errors.AnedyaError{
+		return nil, nil, &errors.AnedyaError{
 			Message: "failed to execute GetNodeDetails request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message: "failed to read GetNodeDetails response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// The body has been consumed; hide it from callers of the Raw variant.
+	resp.Body = http.NoBody
+
 	// Decode response JSON
 	var apiResp GetNodeDetailsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, &errors.AnedyaError{
-			Message: "failed to decode GetNodeDetails response",
-			Err:     errors.ErrResponseDecodeFailed,
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, resp, &errors.AnedyaError{
+			Message:    "failed to decode GetNodeDetails response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// Handle HTTP or API errors
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, resp, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	// Success: return the node details map
-	return apiResp.Data, nil
+	return apiResp.Data, resp, nil
 }
@@ -0,0 +1,37 @@
+package nodes_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestGetNodeDetailsJSONHeaders(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var header http.Header
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeDetails, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	if _, err := client.NodeManagement.GetNodeDetails(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}}); err != nil {
+		t.Fatalf("GetNodeDetails() error = %v", err)
+	}
+	if header == nil {
+		t.Fatal("no request reached the server")
+	}
+	for _, name := range []string{"Content-Type", "Accept"} {
+		if got := header.Values(name); len(got) != 1 || got[0] != "application/json" {
+			t.Fatalf("%s = %v, want [application/json]", name, got)
+		}
+	}
+}
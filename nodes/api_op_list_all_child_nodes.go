@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -110,7 +112,13 @@ func (nm *NodeManagement) ListChildNodes(
 	}
 
 	// Endpoint
-	url := fmt.Sprintf("%s/v1/node/child/list", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeChildList)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build ListChildNodes request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Marshal request
 	body, err := json.Marshal(req)
@@ -135,29 +143,44 @@ func (nm *NodeManagement) ListChildNodes(
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return nil, &errors.AnedyaError{
 			Message: "failed to execute ListChildNodes request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to read ListChildNodes response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
 	// Decode response
 	var apiResp ListChildNodesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return nil, &errors.AnedyaError{
-			Message: "failed to decode ListChildNodes response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode ListChildNodes response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// Centralized API error handling
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	return &apiResp, nil
 }
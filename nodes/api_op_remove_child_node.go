@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -75,7 +77,13 @@ func (nm *NodeManagement) RemoveChildNode(ctx context.Context, req *RemoveChildN
 		}
 	}
 
-	url := fmt.Sprintf("%s/v1/node/child/remove", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeChildRemove)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build RemoveChildNode request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 	body, err := json.Marshal(req)
 	if err != nil {
 		return &errors.AnedyaError{
@@ -92,28 +100,48 @@ func (nm *NodeManagement) RemoveChildNode(ctx context.Context, req *RemoveChildN
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return &errors.AnedyaError{
 			Message: "failed to execute RemoveChildNode request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read RemoveChildNode response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// An empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, respBody) {
+		return nil
+	}
+
 	var apiResp RemoveChildNodeResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return &errors.AnedyaError{
-			Message: "failed to decode RemoveChildNode response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode RemoveChildNode response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// Handle all API errors automatically
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	return nil
 }
@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -21,10 +23,30 @@ const (
 	// UpdateNodeDesc updates the node's description
 	UpdateNodeDesc UpdateType = "node_desc"
 
-	// UpdateTag adds, updates, or removes a tag
+	// UpdateTag adds or updates a tag
 	UpdateTag UpdateType = "tag"
+
+	// UpdateDeleteTag removes the tag with the given key
+	UpdateDeleteTag UpdateType = "delete_tag"
+
+	// UpdateRegenerateKey regenerates the node's connection key
+	UpdateRegenerateKey UpdateType = "regenerate_key"
 )
 
+// isValidUpdateType reports whether t is a supported update type.
+func isValidUpdateType(t UpdateType) bool {
+	switch t {
+	case UpdateNodeName,
+		UpdateNodeDesc,
+		UpdateTag,
+		UpdateDeleteTag,
+		UpdateRegenerateKey:
+		return true
+	default:
+		return false
+	}
+}
+
 // NodeUpdate represents a single update operation
 // applied to a node.
 type NodeUpdate struct {
@@ -34,14 +56,17 @@ type NodeUpdate struct {
 	//   - node_name
 	//   - node_desc
 	//   - tag
+	//   - delete_tag
+	//   - regenerate_key
 	Type UpdateType `json:"type"`
 
 	// Value contains the new value for name or description updates.
-	// This field is mandatory for non-tag updates.
+	// This field is mandatory for UpdateNodeName and UpdateNodeDesc.
 	Value string `json:"value,omitempty"`
 
 	// Tag contains the tag object for tag-related updates.
-	// This field is mandatory when Type is UpdateTag.
+	// This field is mandatory when Type is UpdateTag or UpdateDeleteTag;
+	// only the key is used when deleting a tag.
 	Tag *Tag `json:"tag,omitempty"`
 }
 
@@ -122,29 +147,48 @@ func (nm *NodeManagement) UpdateNode(
 		if u.Type == "" {
 			return &errors.AnedyaError{
 				Message: fmt.Sprintf("update[%d].type is required", i),
-				Err:     fmt.Errorf("update type missing"),
+				Err:     errors.ErrUpdateNodeTypeRequired,
 			}
 		}
 
-		// Tag updates must contain a tag object
-		if u.Type == UpdateTag && u.Tag == nil {
+		// Update type must be supported
+		if !isValidUpdateType(u.Type) {
 			return &errors.AnedyaError{
-				Message: fmt.Sprintf("update[%d].tag is required for tag update", i),
-				Err:     fmt.Errorf("tag object missing"),
+				Message: fmt.Sprintf("update[%d].type %q is not supported", i, u.Type),
+				Err:     errors.ErrUpdateNodeInvalidType,
 			}
 		}
 
-		// Non-tag updates must contain a value
-		if u.Type != UpdateTag && u.Value == "" {
-			return &errors.AnedyaError{
-				Message: fmt.Sprintf("update[%d].value is required", i),
-				Err:     fmt.Errorf("value missing"),
+		switch u.Type {
+
+		// Tag updates must contain a tag object with a key
+		case UpdateTag, UpdateDeleteTag:
+			if u.Tag == nil || u.Tag.Key == "" {
+				return &errors.AnedyaError{
+					Message: fmt.Sprintf("update[%d].tag with a key is required for %s update", i, u.Type),
+					Err:     errors.ErrUpdateNodeTagRequired,
+				}
+			}
+
+		// Name and description updates must contain a value
+		case UpdateNodeName, UpdateNodeDesc:
+			if u.Value == "" {
+				return &errors.AnedyaError{
+					Message: fmt.Sprintf("update[%d].value is required", i),
+					Err:     errors.ErrUpdateNodeValueRequired,
+				}
 			}
 		}
 	}
 
 	// Construct API endpoint URL
-	url := fmt.Sprintf("%s/v1/node/update", nm.baseURL)
+	url, err := common.JoinURL(nm.baseURL, common.APIVersion, common.EndpointNodeUpdate)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build UpdateNode request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 
 	// Marshal request payload into JSON
 	body, err := json.Marshal(req)
@@ -169,29 +213,50 @@ func (nm *NodeManagement) UpdateNode(
 		}
 	}
 
+	common.SetJSONHeaders(httpReq)
+
 	// Execute HTTP request
 	resp, err := nm.httpClient.Do(httpReq)
 	if err != nil {
 		return &errors.AnedyaError{
 			Message: "failed to execute UpdateNode request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
 
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to read UpdateNode response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// Some update operations, such as key regeneration, may succeed
+	// with an empty body
+	if common.IsEmptySuccess(resp, respBody) {
+		return nil
+	}
+
 	// Decode API response
 	var apiResp UpdateNodeResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
 		return &errors.AnedyaError{
-			Message: "failed to decode UpdateNode response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode UpdateNode response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    respBody,
 		}
 	}
 
 	// Handle HTTP or API-level errors
 	if resp.StatusCode != http.StatusOK || !apiResp.Success {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, respBody)
 	}
 
+	common.CaptureDecoded(resp, respBody)
+
 	return nil
 }
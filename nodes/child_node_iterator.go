@@ -0,0 +1,152 @@
+package nodes
+
+import "context"
+
+// ChildNodeIterator pages through the child nodes of a parent node
+// using ListChildNodes.
+//
+// Use Next to advance the iterator and Value to read the current
+// child node. Once Next returns false, Err reports the error that
+// stopped the iteration, if any.
+type ChildNodeIterator struct {
+	// OnProgress, when non-nil, is called after every page with the
+	// number of child nodes fetched so far and the total reported by the API.
+	OnProgress func(fetched, total int)
+
+	ctx      context.Context
+	nm       *NodeManagement
+	parentID string
+	pageSize int
+
+	// offset is the offset of the next page to fetch.
+	offset int
+
+	// fetched is the number of items fetched so far.
+	fetched int
+
+	page    []ChildNode
+	index   int
+	current ChildNode
+
+	// done is set once the last page has been fetched.
+	done bool
+	err  error
+}
+
+// ChildNodesIterator returns an iterator over all child nodes of
+// parentID, fetching pageSize child nodes per request.
+//
+// Pages are requested using the Next offset returned by the API.
+// Iteration stops when a page is empty or when Next does not advance
+// past the current offset, so a misbehaving server cannot cause an
+// infinite loop.
+//
+// A pageSize outside 1..1000 uses the ListChildNodes default of 100.
+func (nm *NodeManagement) ChildNodesIterator(ctx context.Context, parentID string, pageSize int) *ChildNodeIterator {
+	return &ChildNodeIterator{
+		ctx:      ctx,
+		nm:       nm,
+		parentID: parentID,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances the iterator to the next child node.
+//
+// It returns false when all child nodes have been read or an error
+// occurred; use Err to tell the two apart.
+func (it *ChildNodeIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the child node at the current iterator position.
+func (it *ChildNodeIterator) Value() ChildNode {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ChildNodeIterator) Err() error {
+	return it.err
+}
+
+// fetch loads the next page and reports whether it contains
+// any child nodes.
+func (it *ChildNodeIterator) fetch() bool {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	resp, err := it.nm.ListChildNodes(it.ctx, &ListChildNodesRequest{
+		ParentId: it.parentID,
+		Limit:    it.pageSize,
+		Offset:   it.offset,
+	})
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = resp.Data
+	it.index = 0
+
+	if resp.Count == 0 || len(resp.Data) == 0 {
+		it.done = true
+		return false
+	}
+
+	// guard against a Next cursor that does not advance
+	if resp.Next <= it.offset {
+		it.done = true
+	}
+	it.offset = resp.Next
+
+	it.fetched += len(resp.Data)
+	if it.OnProgress != nil {
+		it.OnProgress(it.fetched, resp.TotalCount)
+	}
+
+	return true
+}
+
+// AllChildNodes retrieves every child node of parentID by draining
+// a ChildNodeIterator into a slice.
+//
+// To report progress while collecting, create an iterator with
+// ChildNodesIterator, set its OnProgress and drain it directly.
+func (nm *NodeManagement) AllChildNodes(ctx context.Context, parentID string) ([]ChildNode, error) {
+	it := nm.ChildNodesIterator(ctx, parentID, 100)
+
+	var children []ChildNode
+	for it.Next() {
+		children = append(children, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return children, nil
+}
+
+// ChildNodesIterator returns an iterator over all child nodes
+// attached to this node.
+//
+// See NodeManagement.ChildNodesIterator for paging behaviour.
+func (n *Node) ChildNodesIterator(ctx context.Context, pageSize int) *ChildNodeIterator {
+	if err := n.validate(); err != nil {
+		return &ChildNodeIterator{err: err}
+	}
+
+	return n.nodeManagement.ChildNodesIterator(ctx, n.NodeId, pageSize)
+}
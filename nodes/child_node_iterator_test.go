@@ -0,0 +1,144 @@
+package nodes_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestChildNodesIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		pageSize  int
+		wantPages int32
+	}{
+		// the empty page after the last one ends the iteration
+		{name: "several pages", total: 5, pageSize: 2, wantPages: 4},
+		{name: "single page", total: 3, pageSize: 10, wantPages: 2},
+		{name: "no children", total: 0, pageSize: 2, wantPages: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			children := make([]nodes.ChildNode, tt.total)
+			for i := range children {
+				children[i] = nodes.ChildNode{ChildId: fmt.Sprintf("c%d", i)}
+			}
+			pages := handleChildList(mock, children)
+
+			client := mock.Client()
+			defer client.Close()
+
+			it := client.NodeManagement.ChildNodesIterator(context.Background(), "parent", tt.pageSize)
+
+			var got []string
+			for it.Next() {
+				got = append(got, it.Value().ChildId)
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+
+			if len(got) != tt.total {
+				t.Fatalf("iterated %v, want %d children", got, tt.total)
+			}
+			for i, id := range got {
+				if want := fmt.Sprintf("c%d", i); id != want {
+					t.Fatalf("child %d = %q, want %q", i, id, want)
+				}
+			}
+
+			// an exhausted iterator stays exhausted without new requests
+			if it.Next() {
+				t.Fatal("Next() = true after the last child")
+			}
+			if got := pages.Load(); got != tt.wantPages {
+				t.Fatalf("requested %d pages, want %d", got, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestChildNodesIteratorStalledCursor(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeChildList, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		// Next never advances past the first page
+		json.NewEncoder(w).Encode(&nodes.ListChildNodesResponse{
+			Success:    true,
+			TotalCount: 10,
+			Count:      1,
+			Next:       0,
+			Data:       []nodes.ChildNode{{ChildId: "c0"}},
+		})
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	children, err := client.NodeManagement.AllChildNodes(context.Background(), "parent")
+	if err != nil {
+		t.Fatalf("AllChildNodes() error = %v", err)
+	}
+	if len(children) != 1 || requests != 1 {
+		t.Fatalf("got %d children in %d requests, want 1 in 1", len(children), requests)
+	}
+}
+
+func TestChildNodesIteratorError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeChildList, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"success":false,"error":"boom"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&nodes.ListChildNodesResponse{
+			Success:    true,
+			TotalCount: 10,
+			Count:      2,
+			Next:       2,
+			Data:       []nodes.ChildNode{{ChildId: "c0"}, {ChildId: "c1"}},
+		})
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	it := client.NodeManagement.ChildNodesIterator(context.Background(), "parent", 2)
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 {
+		t.Fatalf("iterated %d children, want the 2 of the first page", count)
+	}
+	if it.Err() == nil {
+		t.Fatal("Err() = nil, want the second page error")
+	}
+	if it.Next() {
+		t.Fatal("Next() = true after an error")
+	}
+	if requests != 2 {
+		t.Fatalf("made %d requests, want 2", requests)
+	}
+}
@@ -0,0 +1,118 @@
+package nodes_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedya"
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// TestEmptySuccessBodies checks every NodeManagement method that
+// returns only an error against empty and JSON response bodies.
+func TestEmptySuccessBodies(t *testing.T) {
+	methods := []struct {
+		name     string
+		endpoint string
+		call     func(ctx context.Context, c *anedya.Client) error
+	}{
+		{
+			name:     "DeleteNode",
+			endpoint: common.EndpointNodeDelete,
+			call: func(ctx context.Context, c *anedya.Client) error {
+				return c.NodeManagement.DeleteNode(ctx, &nodes.DeleteNodeRequest{NodeID: "n1"})
+			},
+		},
+		{
+			name:     "UpdateNode",
+			endpoint: common.EndpointNodeUpdate,
+			call: func(ctx context.Context, c *anedya.Client) error {
+				return c.NodeManagement.UpdateNode(ctx, &nodes.UpdateNodeRequest{
+					NodeID:  "n1",
+					Updates: []nodes.NodeUpdate{{Type: nodes.UpdateNodeName, Value: "pump"}},
+				})
+			},
+		},
+		{
+			name:     "AuthorizeDevice",
+			endpoint: common.EndpointNodeAuthorize,
+			call: func(ctx context.Context, c *anedya.Client) error {
+				return c.NodeManagement.AuthorizeDevice(ctx, &nodes.AuthorizeDeviceRequest{NodeID: "n1", DeviceID: "d1"})
+			},
+		},
+		{
+			name:     "DeauthorizeDevice",
+			endpoint: common.EndpointNodeDeauthorize,
+			call: func(ctx context.Context, c *anedya.Client) error {
+				return c.NodeManagement.DeauthorizeDevice(ctx, &nodes.DeauthorizeDeviceRequest{NodeID: "n1", DeviceID: "d1"})
+			},
+		},
+		{
+			name:     "AddChildNode",
+			endpoint: common.EndpointNodeChildAdd,
+			call: func(ctx context.Context, c *anedya.Client) error {
+				return c.NodeManagement.AddChildNode(ctx, &nodes.AddChildNodeRequest{
+					ParentId:   "n1",
+					ChildNodes: []nodes.ChildNodeRequest{{NodeId: "c1", Alias: "child"}},
+				})
+			},
+		},
+		{
+			name:     "RemoveChildNode",
+			endpoint: common.EndpointNodeChildRemove,
+			call: func(ctx context.Context, c *anedya.Client) error {
+				return c.NodeManagement.RemoveChildNode(ctx, &nodes.RemoveChildNodeRequest{ParentId: "n1", ChildNode: "c1"})
+			},
+		},
+		{
+			name:     "ClearChildNodes",
+			endpoint: common.EndpointNodeChildClear,
+			call: func(ctx context.Context, c *anedya.Client) error {
+				return c.NodeManagement.ClearChildNodes(ctx, &nodes.ClearChildNodesRequest{ParentId: "n1"})
+			},
+		},
+	}
+
+	bodies := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{name: "empty 200", status: http.StatusOK},
+		{name: "empty 204", status: http.StatusNoContent},
+		{name: "JSON success", status: http.StatusOK, body: `{"success":true}`},
+		{name: "empty error status", status: http.StatusInternalServerError, wantErr: errors.ErrResponseDecodeFailed},
+		{name: "JSON failure", status: http.StatusOK, body: `{"success":false,"error":"boom"}`, wantErr: errors.ErrUnknown},
+	}
+
+	for _, m := range methods {
+		for _, b := range bodies {
+			t.Run(m.name+"/"+b.name, func(t *testing.T) {
+				mock := anedyatest.NewServer()
+				defer mock.Close()
+
+				mock.Handle("/"+common.APIVersion+"/"+m.endpoint, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(b.status)
+					w.Write([]byte(b.body))
+				})
+
+				client := mock.Client()
+				defer client.Close()
+
+				err := m.call(context.Background(), client)
+				if b.wantErr == nil && err != nil {
+					t.Fatalf("error = %v, want nil", err)
+				}
+				if b.wantErr != nil && !stderrors.Is(err, b.wantErr) {
+					t.Fatalf("error = %v, want %v", err, b.wantErr)
+				}
+			})
+		}
+	}
+}
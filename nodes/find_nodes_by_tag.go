@@ -0,0 +1,89 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// FindNodesByTag returns the IDs of nodes carrying a tag with the given
+// key and value, in the same paginated shape as GetNodeList.
+//
+// The Get Node List API does not support tag filters, so the filter is
+// applied client-side: every node is listed and its details fetched
+// through a NodeIterator, and the matching IDs are then paginated with
+// limit and offset. Each call therefore reads the whole node list;
+// callers searching repeatedly should cache the result.
+//
+// An empty value matches any node carrying a tag with the given key.
+// A limit of zero or less uses 100 and a negative offset is treated
+// as zero. TotalCount in the response is the number of matching nodes.
+//
+// Parameters:
+//   - ctx: Context for controlling request cancellation and timeout.
+//   - key: Tag key to match; must not be empty.
+//   - value: Tag value to match, or empty to match any value.
+//   - limit: Maximum number of node IDs to return.
+//   - offset: Number of matching node IDs to skip.
+//
+// Returns:
+//   - *GetNodeListResponse: Matching node IDs and pagination metadata.
+//   - error: Validation, network, or API error.
+func (nm *NodeManagement) FindNodesByTag(
+	ctx context.Context,
+	key, value string,
+	limit, offset int,
+) (*GetNodeListResponse, error) {
+
+	// Validate tag key
+	if key == "" {
+		return nil, &errors.AnedyaError{
+			Message: "tag key is required",
+			Err:     errors.ErrNodeTagKeyRequired,
+		}
+	}
+
+	// Normalize pagination
+	if limit <= 0 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Collect all matching node IDs
+	var matched []string
+	it := nm.NodesIterator(ctx, "asc", 100)
+	for it.Next() {
+		if hasTag(it.Value().Tags, key, value) {
+			matched = append(matched, it.Value().NodeId)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	// Apply pagination to the matched set
+	start := min(offset, len(matched))
+	end := min(start+limit, len(matched))
+	page := matched[start:end]
+
+	return &GetNodeListResponse{
+		Success:      true,
+		CurrentCount: len(page),
+		TotalCount:   len(matched),
+		Nodes:        page,
+		Offset:       offset,
+	}, nil
+}
+
+// hasTag reports whether tags contains key, with the given value
+// unless value is empty.
+func hasTag(tags []Tag, key, value string) bool {
+	for _, t := range tags {
+		if t.Key == key && (value == "" || t.Value == value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package nodes_test
+
+import (
+	"context"
+	stderrors "errors"
+	"slices"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestFindNodesByTag(t *testing.T) {
+	// 250 nodes so the scan spans several node list pages; every
+	// third node is in zone a, every fifth is in zone b
+	ids := nodeIDs(250)
+	tags := func(i int) []nodes.Tag {
+		var tags []nodes.Tag
+		if i%3 == 0 {
+			tags = append(tags, nodes.Tag{Key: "zone", Value: "a"})
+		}
+		if i%5 == 0 {
+			tags = append(tags, nodes.Tag{Key: "zone", Value: "b"})
+		}
+		return tags
+	}
+	matching := func(keep func(i int) bool) []string {
+		var want []string
+		for i, id := range ids {
+			if keep(i) {
+				want = append(want, id)
+			}
+		}
+		return want
+	}
+
+	zoneA := matching(func(i int) bool { return i%3 == 0 })
+	anyZone := matching(func(i int) bool { return i%3 == 0 || i%5 == 0 })
+
+	tests := []struct {
+		name          string
+		key, value    string
+		limit, offset int
+		want          []string
+		wantTotal     int
+	}{
+		{name: "key and value", key: "zone", value: "a", limit: 1000, want: zoneA, wantTotal: len(zoneA)},
+		{name: "any value", key: "zone", limit: 1000, want: anyZone, wantTotal: len(anyZone)},
+		{name: "no match", key: "zone", value: "c", limit: 10, want: nil, wantTotal: 0},
+		{name: "paginated", key: "zone", value: "a", limit: 5, offset: 10, want: zoneA[10:15], wantTotal: len(zoneA)},
+		{name: "offset past the end", key: "zone", value: "a", limit: 5, offset: 500, want: nil, wantTotal: len(zoneA)},
+		{name: "default limit", key: "zone", want: anyZone[:100], wantTotal: len(anyZone)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			handleNodeList(mock, ids)
+			mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+				data := make(map[string]nodes.Node, len(req.Nodes))
+				for _, id := range req.Nodes {
+					data[id] = nodes.Node{NodeId: id, Tags: tags(slices.Index(ids, id))}
+				}
+				return data, nil
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			resp, err := client.NodeManagement.FindNodesByTag(context.Background(), tt.key, tt.value, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("FindNodesByTag() error = %v", err)
+			}
+			if !slices.Equal(resp.Nodes, tt.want) {
+				t.Fatalf("Nodes = %v, want %v", resp.Nodes, tt.want)
+			}
+			if resp.CurrentCount != len(tt.want) || resp.TotalCount != tt.wantTotal || resp.Offset != tt.offset {
+				t.Fatalf("CurrentCount, TotalCount, Offset = %d, %d, %d, want %d, %d, %d",
+					resp.CurrentCount, resp.TotalCount, resp.Offset, len(tt.want), tt.wantTotal, tt.offset)
+			}
+		})
+	}
+}
+
+func TestFindNodesByTagRequiresKey(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	_, err := client.NodeManagement.FindNodesByTag(context.Background(), "", "a", 10, 0)
+	if !stderrors.Is(err, errors.ErrNodeTagKeyRequired) {
+		t.Fatalf("FindNodesByTag() error = %v, want %v", err, errors.ErrNodeTagKeyRequired)
+	}
+}
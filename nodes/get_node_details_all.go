@@ -0,0 +1,79 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// defaultNodeDetailsChunkSize is the number of node IDs sent per
+// GetNodeDetails call by helpers that resolve many nodes at once.
+const defaultNodeDetailsChunkSize = 100
+
+// GetNodeDetailsAll retrieves details for an arbitrary number of nodes
+// by splitting nodeIDs into chunks of at most chunkSize IDs and issuing
+// one GetNodeDetails request per chunk.
+//
+// Duplicate and empty IDs are dropped before chunking. At most
+// common.MaxConcurrentRequests chunks are read in parallel and their
+// results are merged into a single map keyed by node ID.
+//
+// The first failing chunk cancels all outstanding requests and its
+// error is returned without partial results.
+//
+// Parameters:
+//   - ctx: Context used to control request lifecycle, cancellation, and deadlines.
+//   - nodeIDs: Node IDs to resolve.
+//   - chunkSize: Maximum number of node IDs per request; must be greater than 0.
+//
+// Returns:
+//   - (map[string]Node, nil) if every chunk is fetched successfully.
+//   - (nil, error) for validation failures or the first failing chunk.
+func (nm *NodeManagement) GetNodeDetailsAll(
+	ctx context.Context,
+	nodeIDs []string,
+	chunkSize int,
+) (map[string]Node, error) {
+
+	// Validate chunk size
+	if chunkSize <= 0 {
+		return nil, errors.NewFieldError("chunkSize", chunkSize, "must be greater than 0", errors.ErrInvalidInput)
+	}
+
+	// Drop empty and duplicate IDs, keeping input order
+	seen := make(map[string]bool, len(nodeIDs))
+	ids := make([]string, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return nil, errors.NewFieldError("nodeIDs", nodeIDs, "must contain at least one non-empty node ID", errors.ErrInvalidInput)
+	}
+
+	result := make(map[string]Node, len(ids))
+	chunks := (len(ids) + chunkSize - 1) / chunkSize
+
+	err := common.FanOut(ctx, chunks,
+		func(ctx context.Context, i int) (map[string]Node, error) {
+			start := i * chunkSize
+			end := min(start+chunkSize, len(ids))
+			return nm.GetNodeDetails(ctx, &GetNodeDetailsRequest{Nodes: ids[start:end]})
+		},
+		func(i int, details map[string]Node) {
+			for id, node := range details {
+				result[id] = node
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
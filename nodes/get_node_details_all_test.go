@@ -0,0 +1,165 @@
+package nodes_test
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestGetNodeDetailsAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		ids        []string
+		chunkSize  int
+		wantChunks []int // sorted request sizes
+		wantNodes  int
+	}{
+		{name: "single chunk", ids: nodeIDs(3), chunkSize: 100, wantChunks: []int{3}, wantNodes: 3},
+		{name: "exact chunks", ids: nodeIDs(200), chunkSize: 100, wantChunks: []int{100, 100}, wantNodes: 200},
+		{name: "short last chunk", ids: nodeIDs(250), chunkSize: 100, wantChunks: []int{50, 100, 100}, wantNodes: 250},
+		{name: "small chunks", ids: nodeIDs(5), chunkSize: 2, wantChunks: []int{1, 2, 2}, wantNodes: 5},
+		{
+			name:       "duplicates and empty IDs dropped",
+			ids:        []string{"n0", "", "n1", "n0", "n2", "n1"},
+			chunkSize:  2,
+			wantChunks: []int{1, 2},
+			wantNodes:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var mu sync.Mutex
+			var chunks []int
+			requested := make(map[string]int)
+			mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				chunks = append(chunks, len(req.Nodes))
+				data := make(map[string]nodes.Node, len(req.Nodes))
+				for _, id := range req.Nodes {
+					requested[id]++
+					data[id] = nodes.Node{NodeId: id, NodeName: "name-" + id}
+				}
+				return data, nil
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			got, err := client.NodeManagement.GetNodeDetailsAll(context.Background(), tt.ids, tt.chunkSize)
+			if err != nil {
+				t.Fatalf("GetNodeDetailsAll() error = %v", err)
+			}
+
+			sort.Ints(chunks)
+			if !reflect.DeepEqual(chunks, tt.wantChunks) {
+				t.Fatalf("request sizes = %v, want %v", chunks, tt.wantChunks)
+			}
+			for id, n := range requested {
+				if n != 1 {
+					t.Fatalf("node %q requested %d times, want once", id, n)
+				}
+			}
+
+			if len(got) != tt.wantNodes {
+				t.Fatalf("GetNodeDetailsAll() returned %d nodes, want %d", len(got), tt.wantNodes)
+			}
+			for _, id := range tt.ids {
+				if id == "" {
+					continue
+				}
+				if node, ok := got[id]; !ok || node.NodeName != "name-"+id {
+					t.Fatalf("node %q = %+v, want it merged from its chunk", id, node)
+				}
+			}
+		})
+	}
+}
+
+func TestGetNodeDetailsAllValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		ids       []string
+		chunkSize int
+		wantField string
+	}{
+		{name: "zero chunk size", ids: nodeIDs(3), chunkSize: 0, wantField: "chunkSize"},
+		{name: "negative chunk size", ids: nodeIDs(3), chunkSize: -1, wantField: "chunkSize"},
+		{name: "no IDs", ids: nil, chunkSize: 100, wantField: "nodeIDs"},
+		{name: "only empty IDs", ids: []string{"", ""}, chunkSize: 100, wantField: "nodeIDs"},
+	}
+
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+		requests++
+		return nil, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.NodeManagement.GetNodeDetailsAll(context.Background(), tt.ids, tt.chunkSize)
+			if !stderrors.Is(err, errors.ErrInvalidInput) {
+				t.Fatalf("GetNodeDetailsAll() error = %v, want %v", err, errors.ErrInvalidInput)
+			}
+
+			var fe *errors.FieldError
+			if !stderrors.As(err, &fe) {
+				t.Fatalf("errors.As(%v, *FieldError) = false, want true", err)
+			}
+			if fe.Field != tt.wantField {
+				t.Fatalf("FieldError.Field = %q, want %q", fe.Field, tt.wantField)
+			}
+		})
+	}
+
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want 0", requests)
+	}
+}
+
+func TestGetNodeDetailsAllChunkError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+		for _, id := range req.Nodes {
+			if id == "n150" {
+				return nil, &errors.AnedyaError{Message: "boom", StatusCode: 500}
+			}
+		}
+		data := make(map[string]nodes.Node, len(req.Nodes))
+		for _, id := range req.Nodes {
+			data[id] = nodes.Node{NodeId: id}
+		}
+		return data, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	got, err := client.NodeManagement.GetNodeDetailsAll(context.Background(), nodeIDs(250), 100)
+	if err == nil {
+		t.Fatal("GetNodeDetailsAll() error = nil, want the failing chunk error")
+	}
+	if got != nil {
+		t.Fatalf("GetNodeDetailsAll() = %d nodes, want no partial results", len(got))
+	}
+}
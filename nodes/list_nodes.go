@@ -0,0 +1,32 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+)
+
+// ListNodes retrieves a paginated list of nodes using functional
+// list options.
+//
+//	resp, err := nm.ListNodes(ctx, common.WithLimit(50), common.WithOrder("desc"))
+//
+// The Limit, Offset and Order options are honoured. A zero Limit
+// defaults to 100 and an empty Order defaults to "asc"; any other
+// value is validated by GetNodeList. OrderBy is ignored because the
+// Get Node List API does not support it.
+func (nm *NodeManagement) ListNodes(ctx context.Context, opts ...common.ListOption) (*GetNodeListResponse, error) {
+	o := common.ApplyListOptions(opts...)
+	if o.Limit == 0 {
+		o.Limit = 100
+	}
+	if o.Order == "" {
+		o.Order = "asc"
+	}
+
+	return nm.GetNodeList(ctx, &GetNodeListRequest{
+		Limit:  o.Limit,
+		Offset: o.Offset,
+		Order:  o.Order,
+	})
+}
@@ -0,0 +1,79 @@
+package nodes_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestListNodes(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(ctx context.Context, nm *nodes.NodeManagement) (*nodes.GetNodeListResponse, error)
+		want nodes.GetNodeListRequest
+	}{
+		{
+			name: "defaults",
+			call: func(ctx context.Context, nm *nodes.NodeManagement) (*nodes.GetNodeListResponse, error) {
+				return nm.ListNodes(ctx)
+			},
+			want: nodes.GetNodeListRequest{Limit: 100, Order: "asc"},
+		},
+		{
+			name: "options",
+			call: func(ctx context.Context, nm *nodes.NodeManagement) (*nodes.GetNodeListResponse, error) {
+				return nm.ListNodes(ctx,
+					common.WithLimit(50),
+					common.WithOffset(10),
+					common.WithOrder("desc"),
+					common.WithOrderBy("ignored"),
+				)
+			},
+			want: nodes.GetNodeListRequest{Limit: 50, Offset: 10, Order: "desc"},
+		},
+		{
+			name: "positional request",
+			call: func(ctx context.Context, nm *nodes.NodeManagement) (*nodes.GetNodeListResponse, error) {
+				return nm.GetNodeList(ctx, &nodes.GetNodeListRequest{Limit: 50, Offset: 10, Order: "desc"})
+			},
+			want: nodes.GetNodeListRequest{Limit: 50, Offset: 10, Order: "desc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var got nodes.GetNodeListRequest
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeList, func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&got)
+				json.NewEncoder(w).Encode(&nodes.GetNodeListResponse{
+					Success:      true,
+					CurrentCount: 1,
+					TotalCount:   1,
+					Nodes:        []string{"n1"},
+				})
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			resp, err := tt.call(context.Background(), client.NodeManagement)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("request = %+v, want %+v", got, tt.want)
+			}
+			if len(resp.Nodes) != 1 || resp.Nodes[0] != "n1" {
+				t.Fatalf("Nodes = %v, want [n1]", resp.Nodes)
+			}
+		})
+	}
+}
@@ -66,10 +66,32 @@ func NewNodeManagement(c *http.Client, baseURL string) *NodeManagement {
 
 // ==================== Node Wrapper Methods ====================
 
+// validate checks that the node can be used for API calls: its
+// NodeId must be set and its NodeManagement client initialized, so a
+// zero-value Node fails client-side with ErrNodeIDNotSet instead of
+// sending an empty ID.
+func (n *Node) validate() error {
+	if n.NodeId == "" {
+		return &errors.AnedyaError{
+			Message: "node id is not set",
+			Err:     errors.ErrNodeIDNotSet,
+		}
+	}
+
+	if n.nodeManagement == nil {
+		return &errors.AnedyaError{
+			Message: "node management client is not initialized",
+			Err:     errors.ErrNodeManagementNotInitialized,
+		}
+	}
+
+	return nil
+}
+
 // GetDetails fetches the latest details of the node from the server.
 //
 // This method performs the following:
-//  1. Validates that the NodeManagement client is initialized.
+//  1. Validates that the NodeManagement client is initialized and NodeId is set.
 //  2. Calls the NodeManagement's GetNodeDetails API with the NodeId.
 //  3. Updates the current Node instance with the latest details.
 //
@@ -78,13 +100,10 @@ func NewNodeManagement(c *http.Client, baseURL string) *NodeManagement {
 //
 // Returns:
 //   - *Node: Updated node object with fresh data
-//   - error: Error if NodeManagement is nil, node not found, or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, node not found, or API call fails
 func (n *Node) GetDetails(ctx context.Context) (*Node, error) {
-	if n.nodeManagement == nil {
-		return nil, &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return nil, err
 	}
 
 	req := &GetNodeDetailsRequest{
@@ -122,7 +141,7 @@ func (n *Node) GetDetails(ctx context.Context) (*Node, error) {
 // ListChildNodes returns child nodes attached to this node.
 //
 // This method performs the following:
-//  1. Validates that NodeManagement client is initialized.
+//  1. Validates that NodeManagement client is initialized and NodeId is set.
 //  2. Calls the ListChildNodes API with parent NodeId, limit, and offset for pagination.
 //  3. Returns a slice of child Node instances with the same NodeManagement reference.
 //
@@ -133,13 +152,10 @@ func (n *Node) GetDetails(ctx context.Context) (*Node, error) {
 //
 // Returns:
 //   - []*Node: Slice of child nodes
-//   - error: Error if NodeManagement is nil or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, or API call fails
 func (n *Node) ListChildNodes(ctx context.Context, limit int, offset int) ([]*Node, error) {
-	if n.nodeManagement == nil {
-		return nil, &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return nil, err
 	}
 
 	req := &ListChildNodesRequest{
@@ -167,10 +183,40 @@ func (n *Node) ListChildNodes(ctx context.Context, limit int, offset int) ([]*No
 	return nodes, nil
 }
 
+// ChildCount returns the number of child nodes attached to this node.
+//
+// Only a single child entry is requested from the ListChildNodes API,
+// and the TotalCount it reports is returned, so the count is cheap to
+// obtain even for nodes with many children.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout
+//
+// Returns:
+//   - int: Total number of child nodes
+//   - error: Error if NodeManagement is nil, NodeId is empty, or API call fails
+func (n *Node) ChildCount(ctx context.Context) (int, error) {
+	if err := n.validate(); err != nil {
+		return 0, err
+	}
+
+	req := &ListChildNodesRequest{
+		ParentId: n.NodeId,
+		Limit:    1,
+	}
+
+	resp, err := n.nodeManagement.ListChildNodes(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.TotalCount, nil
+}
+
 // UpdateNode applies updates to the node.
 //
 // This method performs the following:
-//  1. Validates that NodeManagement client is initialized.
+//  1. Validates that NodeManagement client is initialized and NodeId is set.
 //  2. Calls NodeManagement's UpdateNode API with the provided updates.
 //
 // Parameters:
@@ -178,13 +224,10 @@ func (n *Node) ListChildNodes(ctx context.Context, limit int, offset int) ([]*No
 //   - updates: Slice of NodeUpdate containing fields to update
 //
 // Returns:
-//   - error: Error if NodeManagement is nil or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, or API call fails
 func (n *Node) UpdateNode(ctx context.Context, updates []NodeUpdate) error {
-	if n.nodeManagement == nil {
-		return &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return err
 	}
 
 	req := &UpdateNodeRequest{
@@ -207,13 +250,10 @@ func (n *Node) UpdateNode(ctx context.Context, updates []NodeUpdate) error {
 //   - deviceID: Unique device identifier to authorize
 //
 // Returns:
-//   - error: Error if NodeManagement is nil, deviceID is empty, or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, deviceID is empty, or API call fails
 func (n *Node) AuthorizeDevice(ctx context.Context, deviceID string) error {
-	if n.nodeManagement == nil {
-		return &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return err
 	}
 
 	if deviceID == "" {
@@ -236,6 +276,39 @@ func (n *Node) AuthorizeDevice(ctx context.Context, deviceID string) error {
 	return nil
 }
 
+// DeauthorizeDevice revokes the binding between a device and this node.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout
+//   - deviceID: Unique device identifier to deauthorize
+//
+// Returns:
+//   - error: Error if NodeManagement is nil, NodeId is empty, deviceID is empty, or API call fails
+func (n *Node) DeauthorizeDevice(ctx context.Context, deviceID string) error {
+	if err := n.validate(); err != nil {
+		return err
+	}
+
+	if deviceID == "" {
+		return &errors.AnedyaError{
+			Message: "deviceID is required",
+			Err:     errors.ErrInputRequired,
+		}
+	}
+
+	req := &DeauthorizeDeviceRequest{
+		NodeID:   n.NodeId,
+		DeviceID: deviceID,
+	}
+
+	// Call NodeManagement method
+	if err := n.nodeManagement.DeauthorizeDevice(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddChildNode attaches one or more child nodes to this node.
 //
 // Parameters:
@@ -243,13 +316,10 @@ func (n *Node) AuthorizeDevice(ctx context.Context, deviceID string) error {
 //   - childNodes: Slice of ChildNodeRequest containing child IDs and aliases
 //
 // Returns:
-//   - error: Error if NodeManagement is nil or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, or API call fails
 func (n *Node) AddChildNode(ctx context.Context, childNodes []ChildNodeRequest) error {
-	if n.nodeManagement == nil {
-		return &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return err
 	}
 
 	if len(childNodes) == 0 {
@@ -277,13 +347,10 @@ func (n *Node) AddChildNode(ctx context.Context, childNodes []ChildNodeRequest)
 //   - ctx: Context for request cancellation and timeout
 //
 // Returns:
-//   - error: Error if NodeManagement is nil or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, or API call fails
 func (n *Node) ClearChildNodes(ctx context.Context) error {
-	if n.nodeManagement == nil {
-		return &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return err
 	}
 
 	req := &ClearChildNodesRequest{
@@ -306,13 +373,10 @@ func (n *Node) ClearChildNodes(ctx context.Context) error {
 //
 // Returns:
 //   - string: Connection key
-//   - error: Error if NodeManagement is nil or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, or API call fails
 func (n *Node) GetConnectionKey(ctx context.Context) (string, error) {
-	if n.nodeManagement == nil {
-		return "", &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return "", err
 	}
 
 	req := &GetConnectionKeyRequest{
@@ -327,6 +391,31 @@ func (n *Node) GetConnectionKey(ctx context.Context) (string, error) {
 	return key, nil
 }
 
+// RegenerateConnectionKey rotates the connection key of this node and
+// stores the new key in ConnectionKey.
+//
+// Devices using the previous key must be reconfigured with the new one.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout
+//
+// Returns:
+//   - string: The new connection key
+//   - error: Error if NodeManagement is nil, NodeId is empty, or an API call fails
+func (n *Node) RegenerateConnectionKey(ctx context.Context) (string, error) {
+	if err := n.validate(); err != nil {
+		return "", err
+	}
+
+	key, err := n.nodeManagement.RegenerateConnectionKey(ctx, n.NodeId)
+	if err != nil {
+		return "", err
+	}
+
+	n.ConnectionKey = key
+	return key, nil
+}
+
 // RemoveChildNode detaches a specific child node from this node.
 //
 // Parameters:
@@ -334,13 +423,10 @@ func (n *Node) GetConnectionKey(ctx context.Context) (string, error) {
 //   - childNodeID: NodeId of the child node to remove
 //
 // Returns:
-//   - error: Error if NodeManagement is nil or API call fails
+//   - error: Error if NodeManagement is nil, NodeId is empty, or API call fails
 func (n *Node) RemoveChildNode(ctx context.Context, childNodeID string) error {
-	if n.nodeManagement == nil {
-		return &errors.AnedyaError{
-			Message: "node management client is not initialized",
-			Err:     errors.ErrNodeManagementNotInitialized,
-		}
+	if err := n.validate(); err != nil {
+		return err
 	}
 
 	if childNodeID == "" {
@@ -362,3 +448,101 @@ func (n *Node) RemoveChildNode(ctx context.Context, childNodeID string) error {
 
 	return nil
 }
+
+// FindOrphanChildren returns the IDs of child nodes attached to this
+// node that no longer exist on the platform.
+//
+// All child nodes are listed and their details are requested with
+// GetNodeDetailsAll in chunks of defaultNodeDetailsChunkSize IDs;
+// children missing from the responses are reported as orphans.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout
+//
+// Returns:
+//   - []string: IDs of child nodes that no longer resolve
+//   - error: Error if NodeManagement is nil, NodeId is empty, or an API call fails
+func (n *Node) FindOrphanChildren(ctx context.Context) ([]string, error) {
+	if err := n.validate(); err != nil {
+		return nil, err
+	}
+
+	children, err := n.nodeManagement.AllChildNodes(ctx, n.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(children) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(children))
+	for _, child := range children {
+		ids = append(ids, child.ChildId)
+	}
+
+	details, err := n.nodeManagement.GetNodeDetailsAll(ctx, ids, defaultNodeDetailsChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []string
+	for _, id := range ids {
+		if _, ok := details[id]; !ok {
+			orphans = append(orphans, id)
+		}
+	}
+
+	return orphans, nil
+}
+
+// BuildAliasIndex returns a map from child alias to child node ID for
+// all child nodes attached to this node, paging through every child.
+//
+// The index is a point-in-time copy that callers may cache to avoid
+// re-listing children on every lookup. It goes stale as soon as
+// children are added, removed or re-aliased, so rebuild it when a
+// lookup misses. Children without an alias are not indexed.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout
+//
+// Returns:
+//   - map[string]string: Alias to child node ID
+//   - error: Error if NodeManagement is nil, NodeId is empty, or an API call fails
+func (n *Node) BuildAliasIndex(ctx context.Context) (map[string]string, error) {
+	if err := n.validate(); err != nil {
+		return nil, err
+	}
+
+	children, err := n.nodeManagement.AllChildNodes(ctx, n.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]string, len(children))
+	for _, child := range children {
+		if child.Alias == "" {
+			continue
+		}
+		index[child.Alias] = child.ChildId
+	}
+
+	return index, nil
+}
+
+// ResolveAlias looks up alias in an index built by BuildAliasIndex.
+//
+// It returns errors.ErrNodeChildNotFound when the alias is not
+// present, which may also mean the index is stale.
+func ResolveAlias(index map[string]string, alias string) (string, error) {
+	childID, ok := index[alias]
+	if !ok {
+		return "", &errors.AnedyaError{
+			Message: fmt.Sprintf("no child node with alias %q", alias),
+			Err:     errors.ErrNodeChildNotFound,
+		}
+	}
+
+	return childID, nil
+}
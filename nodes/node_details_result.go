@@ -0,0 +1,53 @@
+package nodes
+
+import (
+	"context"
+)
+
+// GetNodeDetailsResult separates the nodes resolved by a Get Node
+// Details call from the requested IDs the API did not return.
+type GetNodeDetailsResult struct {
+	// Found contains node details mapped by node ID.
+	Found map[string]Node
+
+	// Missing lists the requested node IDs, in request order, that
+	// are absent from the response. Duplicate IDs are listed once.
+	Missing []string
+}
+
+// GetNodeDetailsWithMissing behaves like GetNodeDetails but also
+// reports which requested node IDs were not returned by the API,
+// for example because they do not exist or are not accessible.
+//
+// Missing is computed by diffing req.Nodes against the returned
+// keys; the request sent to the API is unchanged.
+func (nm *NodeManagement) GetNodeDetailsWithMissing(
+	ctx context.Context,
+	req *GetNodeDetailsRequest,
+) (*GetNodeDetailsResult, error) {
+
+	found, err := nm.GetNodeDetails(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		found = make(map[string]Node)
+	}
+
+	result := &GetNodeDetailsResult{Found: found}
+
+	seen := make(map[string]bool, len(req.Nodes))
+	for _, id := range req.Nodes {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if _, ok := found[id]; !ok {
+			result.Missing = append(result.Missing, id)
+		}
+	}
+
+	return result, nil
+}
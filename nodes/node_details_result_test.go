@@ -0,0 +1,129 @@
+package nodes_test
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestGetNodeDetailsWithMissing(t *testing.T) {
+	tests := []struct {
+		name        string
+		requested   []string
+		existing    []string
+		wantFound   []string
+		wantMissing []string
+	}{
+		{
+			name:      "all found",
+			requested: []string{"n1", "n2"},
+			existing:  []string{"n1", "n2", "n3"},
+			wantFound: []string{"n1", "n2"},
+		},
+		{
+			name:        "some missing in request order",
+			requested:   []string{"n4", "n1", "n3", "n2"},
+			existing:    []string{"n1", "n2"},
+			wantFound:   []string{"n1", "n2"},
+			wantMissing: []string{"n4", "n3"},
+		},
+		{
+			name:        "none found",
+			requested:   []string{"n1", "n2"},
+			wantFound:   []string{},
+			wantMissing: []string{"n1", "n2"},
+		},
+		{
+			name:        "duplicates listed once",
+			requested:   []string{"n9", "n1", "n9", "n1"},
+			existing:    []string{"n1"},
+			wantFound:   []string{"n1"},
+			wantMissing: []string{"n9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			exists := make(map[string]bool, len(tt.existing))
+			for _, id := range tt.existing {
+				exists[id] = true
+			}
+
+			var sent []string
+			mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+				sent = req.Nodes
+				var data map[string]nodes.Node
+				for _, id := range req.Nodes {
+					if exists[id] {
+						if data == nil {
+							data = make(map[string]nodes.Node)
+						}
+						data[id] = nodes.Node{NodeId: id}
+					}
+				}
+				return data, nil
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			got, err := client.NodeManagement.GetNodeDetailsWithMissing(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: tt.requested})
+			if err != nil {
+				t.Fatalf("GetNodeDetailsWithMissing() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(sent, tt.requested) {
+				t.Fatalf("requested %v, want the unchanged %v", sent, tt.requested)
+			}
+
+			if got.Found == nil {
+				t.Fatal("Found = nil, want a non-nil map")
+			}
+			found := make([]string, 0, len(got.Found))
+			for id := range got.Found {
+				found = append(found, id)
+			}
+			sort.Strings(found)
+			if !reflect.DeepEqual(found, tt.wantFound) {
+				t.Fatalf("Found = %v, want %v", found, tt.wantFound)
+			}
+			if !reflect.DeepEqual(got.Missing, tt.wantMissing) {
+				t.Fatalf("Missing = %v, want %v", got.Missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestGetNodeDetailsWithMissingError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+		return nil, &errors.AnedyaError{Message: "boom", StatusCode: 500}
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	got, err := client.NodeManagement.GetNodeDetailsWithMissing(context.Background(), &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+	if err == nil {
+		t.Fatal("GetNodeDetailsWithMissing() error = nil, want the API error")
+	}
+	if got != nil {
+		t.Fatalf("GetNodeDetailsWithMissing() = %+v, want nil", got)
+	}
+
+	_, err = client.NodeManagement.GetNodeDetailsWithMissing(context.Background(), nil)
+	if !stderrors.Is(err, errors.ErrNodeDetailsRequestNil) {
+		t.Fatalf("GetNodeDetailsWithMissing(nil) error = %v, want %v", err, errors.ErrNodeDetailsRequestNil)
+	}
+}
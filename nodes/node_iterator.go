@@ -0,0 +1,161 @@
+package nodes
+
+import "context"
+
+// NodeIterator pages through all nodes using GetNodeList and yields
+// fully populated Node values.
+//
+// Each page of node IDs is resolved with a single GetNodeDetails call.
+// Nodes are yielded in the order returned by GetNodeList; a node that
+// is deleted between the two calls is skipped.
+//
+// Use Next to advance the iterator and Value to read the current
+// node. Once Next returns false, Err reports the error that stopped
+// the iteration, if any.
+type NodeIterator struct {
+	// OnProgress, when non-nil, is called after every page with the
+	// number of node IDs listed so far and the total reported by the API.
+	OnProgress func(fetched, total int)
+
+	ctx      context.Context
+	nm       *NodeManagement
+	order    string
+	pageSize int
+
+	// offset is the offset of the next page to fetch.
+	offset int
+
+	page    []*Node
+	index   int
+	current *Node
+
+	// done is set once the last page has been fetched.
+	done bool
+	err  error
+}
+
+// NodesIterator returns an iterator over all nodes, listing pageSize
+// node IDs per request in the given order ("asc" or "desc").
+//
+// Iteration stops when a page reports a CurrentCount of zero or the
+// offset reaches TotalCount. An empty order defaults to "asc" and a
+// pageSize of zero or less uses 100.
+func (nm *NodeManagement) NodesIterator(ctx context.Context, order string, pageSize int) *NodeIterator {
+	if order == "" {
+		order = "asc"
+	}
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	return &NodeIterator{
+		ctx:      ctx,
+		nm:       nm,
+		order:    order,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances the iterator to the next node.
+//
+// It returns false when all nodes have been read or an error
+// occurred; use Err to tell the two apart.
+func (it *NodeIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the node at the current iterator position.
+//
+// The returned node is bound to the NodeManagement client, so its
+// wrapper methods can be called directly.
+func (it *NodeIterator) Value() *Node {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *NodeIterator) Err() error {
+	return it.err
+}
+
+// fetch lists the next page of node IDs and resolves their details.
+func (it *NodeIterator) fetch() {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return
+	}
+
+	list, err := it.nm.GetNodeList(it.ctx, &GetNodeListRequest{
+		Limit:  it.pageSize,
+		Offset: it.offset,
+		Order:  it.order,
+	})
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page = it.page[:0]
+	it.index = 0
+
+	if list.CurrentCount == 0 || len(list.Nodes) == 0 {
+		it.done = true
+		return
+	}
+
+	it.offset += len(list.Nodes)
+	if it.offset >= list.TotalCount {
+		it.done = true
+	}
+
+	if it.OnProgress != nil {
+		it.OnProgress(it.offset, list.TotalCount)
+	}
+
+	details, err := it.nm.GetNodeDetails(it.ctx, &GetNodeDetailsRequest{
+		Nodes: list.Nodes,
+	})
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	for _, id := range list.Nodes {
+		node, ok := details[id]
+		if !ok {
+			continue
+		}
+		if node.NodeId == "" {
+			node.NodeId = id
+		}
+		node.nodeManagement = it.nm
+		it.page = append(it.page, &node)
+	}
+}
+
+// AllNodes retrieves every node with its details by draining a
+// NodeIterator into a slice.
+//
+// To report progress while collecting, create an iterator with
+// NodesIterator, set its OnProgress and drain it directly.
+func (nm *NodeManagement) AllNodes(ctx context.Context, order string) ([]*Node, error) {
+	it := nm.NodesIterator(ctx, order, 100)
+
+	var nodes []*Node
+	for it.Next() {
+		nodes = append(nodes, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
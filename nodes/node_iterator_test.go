@@ -0,0 +1,364 @@
+package nodes_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// progress records the calls made to an OnProgress callback.
+type progress struct {
+	fetched []int
+	totals  []int
+}
+
+func (p *progress) record(fetched, total int) {
+	p.fetched = append(p.fetched, fetched)
+	p.totals = append(p.totals, total)
+}
+
+// check asserts that fetched increases on every call, ends at total
+// and that every call reported total.
+func (p *progress) check(t *testing.T, total int) {
+	t.Helper()
+
+	if len(p.fetched) == 0 {
+		t.Fatal("OnProgress was never called")
+	}
+	for i := range p.fetched {
+		if i > 0 && p.fetched[i] <= p.fetched[i-1] {
+			t.Fatalf("fetched = %v, want strictly increasing", p.fetched)
+		}
+		if p.totals[i] != total {
+			t.Fatalf("totals = %v, want all %d", p.totals, total)
+		}
+	}
+	if last := p.fetched[len(p.fetched)-1]; last != total {
+		t.Fatalf("last fetched = %d, want %d", last, total)
+	}
+}
+
+// nodeIDs returns n node IDs of the form "n0", "n1", ...
+func nodeIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("n%d", i)
+	}
+	return ids
+}
+
+// page returns the slice of ids selected by offset and limit.
+func page[T any](ids []T, offset, limit int) []T {
+	if offset >= len(ids) {
+		return nil
+	}
+	end := min(offset+limit, len(ids))
+	return ids[offset:end]
+}
+
+// handleNodeList serves ids from the Get Node List endpoint,
+// honouring the requested limit and offset. It returns the number of
+// pages served so far.
+func handleNodeList(mock *anedyatest.MockServer, ids []string) *atomic.Int32 {
+	var pages atomic.Int32
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeList, func(w http.ResponseWriter, r *http.Request) {
+		pages.Add(1)
+
+		var req nodes.GetNodeListRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		p := page(ids, req.Offset, req.Limit)
+		json.NewEncoder(w).Encode(&nodes.GetNodeListResponse{
+			Success:      true,
+			CurrentCount: len(p),
+			TotalCount:   len(ids),
+			Nodes:        p,
+			Offset:       req.Offset,
+		})
+	})
+	return &pages
+}
+
+// handleChildList serves children from the List Child Nodes endpoint,
+// honouring the requested limit and offset. It returns the number of
+// pages served so far.
+func handleChildList(mock *anedyatest.MockServer, children []nodes.ChildNode) *atomic.Int32 {
+	var pages atomic.Int32
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeChildList, func(w http.ResponseWriter, r *http.Request) {
+		pages.Add(1)
+
+		var req nodes.ListChildNodesRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		p := page(children, req.Offset, req.Limit)
+		json.NewEncoder(w).Encode(&nodes.ListChildNodesResponse{
+			Success:    true,
+			TotalCount: len(children),
+			Count:      len(p),
+			Next:       req.Offset + len(p),
+			Data:       p,
+		})
+	})
+	return &pages
+}
+
+// echoNodeDetails stubs Get Node Details to return a node for every
+// requested ID.
+func echoNodeDetails(mock *anedyatest.MockServer) {
+	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+		data := make(map[string]nodes.Node, len(req.Nodes))
+		for _, id := range req.Nodes {
+			data[id] = nodes.Node{NodeId: id}
+		}
+		return data, nil
+	})
+}
+
+func TestNodesIteratorOnProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		pageSize int
+	}{
+		{name: "several full pages", total: 6, pageSize: 2},
+		{name: "short last page", total: 7, pageSize: 3},
+		{name: "single page", total: 2, pageSize: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			handleNodeList(mock, nodeIDs(tt.total))
+			echoNodeDetails(mock)
+
+			client := mock.Client()
+			defer client.Close()
+
+			var p progress
+			it := client.NodeManagement.NodesIterator(context.Background(), "asc", tt.pageSize)
+			it.OnProgress = p.record
+
+			count := 0
+			for it.Next() {
+				count++
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if count != tt.total {
+				t.Fatalf("iterated %d nodes, want %d", count, tt.total)
+			}
+			p.check(t, tt.total)
+		})
+	}
+}
+
+func TestChildNodesIteratorOnProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		pageSize int
+	}{
+		{name: "several full pages", total: 6, pageSize: 2},
+		{name: "short last page", total: 5, pageSize: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			children := make([]nodes.ChildNode, tt.total)
+			for i := range children {
+				children[i] = nodes.ChildNode{ChildId: fmt.Sprintf("c%d", i)}
+			}
+			handleChildList(mock, children)
+
+			client := mock.Client()
+			defer client.Close()
+
+			var p progress
+			it := client.NodeManagement.ChildNodesIterator(context.Background(), "parent", tt.pageSize)
+			it.OnProgress = p.record
+
+			count := 0
+			for it.Next() {
+				count++
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if count != tt.total {
+				t.Fatalf("iterated %d children, want %d", count, tt.total)
+			}
+			p.check(t, tt.total)
+		})
+	}
+}
+
+func TestNodesIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		pageSize  int
+		wantPages int32
+	}{
+		{name: "several pages", total: 5, pageSize: 2, wantPages: 3},
+		{name: "stops at exact last page", total: 4, pageSize: 2, wantPages: 2},
+		{name: "no nodes", total: 0, pageSize: 2, wantPages: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			pages := handleNodeList(mock, nodeIDs(tt.total))
+			echoNodeDetails(mock)
+
+			client := mock.Client()
+			defer client.Close()
+
+			it := client.NodeManagement.NodesIterator(context.Background(), "asc", tt.pageSize)
+
+			var got []string
+			for it.Next() {
+				got = append(got, it.Value().NodeId)
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if want := nodeIDs(tt.total); !slices.Equal(got, want) {
+				t.Fatalf("iterated %v, want %v", got, want)
+			}
+
+			// an exhausted iterator stays exhausted without new requests
+			if it.Next() {
+				t.Fatal("Next() = true after the last node")
+			}
+			if got := pages.Load(); got != tt.wantPages {
+				t.Fatalf("requested %d pages, want %d", got, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestNodesIteratorSkipsDeletedNodes(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	handleNodeList(mock, nodeIDs(3))
+
+	// n1 is deleted between listing and fetching details
+	mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+		data := make(map[string]nodes.Node, len(req.Nodes))
+		for _, id := range req.Nodes {
+			if id != "n1" {
+				data[id] = nodes.Node{NodeId: id}
+			}
+		}
+		return data, nil
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	all, err := client.NodeManagement.AllNodes(context.Background(), "asc")
+	if err != nil {
+		t.Fatalf("AllNodes() error = %v", err)
+	}
+	var got []string
+	for _, n := range all {
+		got = append(got, n.NodeId)
+	}
+	if want := []string{"n0", "n2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("AllNodes() = %v, want %v", got, want)
+	}
+}
+
+func TestNodesIteratorError(t *testing.T) {
+	tests := []struct {
+		name string
+		// failList fails the second Get Node List call, otherwise the
+		// second Get Node Details call fails.
+		failList bool
+	}{
+		{name: "list fails", failList: true},
+		{name: "details fail"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			ids := nodeIDs(6)
+			listCalls := 0
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeList, func(w http.ResponseWriter, r *http.Request) {
+				listCalls++
+				if tt.failList && listCalls > 1 {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(`{"success":false,"error":"boom"}`))
+					return
+				}
+
+				var req nodes.GetNodeListRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				p := page(ids, req.Offset, req.Limit)
+				json.NewEncoder(w).Encode(&nodes.GetNodeListResponse{
+					Success:      true,
+					CurrentCount: len(p),
+					TotalCount:   len(ids),
+					Nodes:        p,
+					Offset:       req.Offset,
+				})
+			})
+			detailCalls := 0
+			mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+				detailCalls++
+				if !tt.failList && detailCalls > 1 {
+					return nil, &errors.AnedyaError{Message: "boom", StatusCode: http.StatusInternalServerError}
+				}
+				data := make(map[string]nodes.Node, len(req.Nodes))
+				for _, id := range req.Nodes {
+					data[id] = nodes.Node{NodeId: id}
+				}
+				return data, nil
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			it := client.NodeManagement.NodesIterator(context.Background(), "asc", 2)
+
+			count := 0
+			for it.Next() {
+				count++
+			}
+			if count != 2 {
+				t.Fatalf("iterated %d nodes, want the 2 of the first page", count)
+			}
+			if it.Err() == nil {
+				t.Fatal("Err() = nil, want the second page error")
+			}
+			if it.Next() {
+				t.Fatal("Next() = true after an error")
+			}
+			if listCalls != 2 {
+				t.Fatalf("made %d list requests, want 2", listCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package nodes_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// boundNode returns the node id bound to nm, obtained the way
+// callers do: by listing nodes through the mock.
+func boundNode(t *testing.T, mock *anedyatest.MockServer, nm *nodes.NodeManagement, id string) *nodes.Node {
+	t.Helper()
+
+	handleNodeList(mock, []string{id})
+	echoNodeDetails(mock)
+
+	all, err := nm.AllNodes(context.Background(), "asc")
+	if err != nil {
+		t.Fatalf("AllNodes() error = %v", err)
+	}
+	if len(all) != 1 {
+		t.Fatalf("AllNodes() returned %d nodes, want 1", len(all))
+	}
+	return all[0]
+}
+
+func TestNodeChildCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int
+	}{
+		{name: "no children", total: 0},
+		{name: "many children", total: 2500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			client := mock.Client()
+			defer client.Close()
+
+			node := boundNode(t, mock, client.NodeManagement, "parent")
+
+			var reqs []nodes.ListChildNodesRequest
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeChildList, func(w http.ResponseWriter, r *http.Request) {
+				var req nodes.ListChildNodesRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				reqs = append(reqs, req)
+
+				resp := nodes.ListChildNodesResponse{Success: true, TotalCount: tt.total}
+				if tt.total > 0 {
+					resp.Count = 1
+					resp.Next = 1
+					resp.Data = []nodes.ChildNode{{ChildId: "c0"}}
+				}
+				json.NewEncoder(w).Encode(&resp)
+			})
+
+			got, err := node.ChildCount(context.Background())
+			if err != nil {
+				t.Fatalf("ChildCount() error = %v", err)
+			}
+			if got != tt.total {
+				t.Fatalf("ChildCount() = %d, want %d", got, tt.total)
+			}
+
+			// the count must come from a single, minimal page
+			if len(reqs) != 1 {
+				t.Fatalf("ChildCount() made %d requests, want 1", len(reqs))
+			}
+			if reqs[0].ParentId != "parent" || reqs[0].Limit != 1 {
+				t.Fatalf("request = %+v, want parent %q with limit 1", reqs[0], "parent")
+			}
+		})
+	}
+}
+
+func TestNodeFindOrphanChildren(t *testing.T) {
+	tests := []struct {
+		name     string
+		children int
+		// deleted reports whether the child with the given index
+		// no longer exists.
+		deleted     func(i int) bool
+		wantOrphans []string
+	}{
+		{
+			name:     "no children",
+			children: 0,
+			deleted:  func(i int) bool { return false },
+		},
+		{
+			name:     "all children live",
+			children: 3,
+			deleted:  func(i int) bool { return false },
+		},
+		{
+			name:        "mix of live and deleted",
+			children:    5,
+			deleted:     func(i int) bool { return i%2 == 1 },
+			wantOrphans: []string{"c1", "c3"},
+		},
+		{
+			name:        "more children than one details request",
+			children:    250,
+			deleted:     func(i int) bool { return i == 7 || i == 180 },
+			wantOrphans: []string{"c7", "c180"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			client := mock.Client()
+			defer client.Close()
+
+			node := boundNode(t, mock, client.NodeManagement, "parent")
+
+			children := make([]nodes.ChildNode, tt.children)
+			live := make(map[string]bool, tt.children)
+			for i := range children {
+				id := fmt.Sprintf("c%d", i)
+				children[i] = nodes.ChildNode{ChildId: id}
+				live[id] = !tt.deleted(i)
+			}
+			handleChildList(mock, children)
+
+			var mu sync.Mutex
+			var largest int
+			mock.OnNodeDetails(func(req nodes.GetNodeDetailsRequest) (map[string]nodes.Node, error) {
+				mu.Lock()
+				largest = max(largest, len(req.Nodes))
+				mu.Unlock()
+
+				data := make(map[string]nodes.Node)
+				for _, id := range req.Nodes {
+					if live[id] {
+						data[id] = nodes.Node{NodeId: id}
+					}
+				}
+				return data, nil
+			})
+
+			orphans, err := node.FindOrphanChildren(context.Background())
+			if err != nil {
+				t.Fatalf("FindOrphanChildren() error = %v", err)
+			}
+			if !reflect.DeepEqual(orphans, tt.wantOrphans) {
+				t.Fatalf("FindOrphanChildren() = %v, want %v", orphans, tt.wantOrphans)
+			}
+			if largest > 100 {
+				t.Fatalf("GetNodeDetails received %d IDs in one request, want at most 100", largest)
+			}
+		})
+	}
+}
+
+func TestNodeBuildAliasIndex(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	node := boundNode(t, mock, client.NodeManagement, "parent")
+
+	// more children than fit in one page of AllChildNodes
+	children := make([]nodes.ChildNode, 230)
+	for i := range children {
+		children[i] = nodes.ChildNode{ChildId: fmt.Sprintf("c%d", i), Alias: fmt.Sprintf("alias-%d", i)}
+	}
+	children[5].Alias = ""
+
+	pages := handleChildList(mock, children)
+
+	index, err := node.BuildAliasIndex(context.Background())
+	if err != nil {
+		t.Fatalf("BuildAliasIndex() error = %v", err)
+	}
+	if n := pages.Load(); n < 2 {
+		t.Fatalf("BuildAliasIndex() listed %d pages, want several", n)
+	}
+	if len(index) != len(children)-1 {
+		t.Fatalf("index has %d aliases, want %d", len(index), len(children)-1)
+	}
+
+	tests := []struct {
+		name    string
+		alias   string
+		want    string
+		wantErr error
+	}{
+		{name: "first page", alias: "alias-0", want: "c0"},
+		{name: "last page", alias: "alias-229", want: "c229"},
+		{name: "children without alias are not indexed", alias: "", wantErr: errors.ErrNodeChildNotFound},
+		{name: "unknown alias", alias: "missing", wantErr: errors.ErrNodeChildNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nodes.ResolveAlias(index, tt.alias)
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("ResolveAlias(%q) error = %v, want %v", tt.alias, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("ResolveAlias(%q) = %q, %v, want %q", tt.alias, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeWrappersRequireNodeID(t *testing.T) {
+	ctx := context.Background()
+
+	wrappers := []struct {
+		name string
+		call func(n *nodes.Node) error
+	}{
+		{"GetDetails", func(n *nodes.Node) error { _, err := n.GetDetails(ctx); return err }},
+		{"ListChildNodes", func(n *nodes.Node) error { _, err := n.ListChildNodes(ctx, 10, 0); return err }},
+		{"ChildCount", func(n *nodes.Node) error { _, err := n.ChildCount(ctx); return err }},
+		{"ChildNodesIterator", func(n *nodes.Node) error {
+			it := n.ChildNodesIterator(ctx, 10)
+			if it.Next() {
+				return nil
+			}
+			return it.Err()
+		}},
+		{"UpdateNode", func(n *nodes.Node) error {
+			return n.UpdateNode(ctx, []nodes.NodeUpdate{{Type: nodes.UpdateNodeName, Value: "pump"}})
+		}},
+		{"AuthorizeDevice", func(n *nodes.Node) error { return n.AuthorizeDevice(ctx, "d1") }},
+		{"DeauthorizeDevice", func(n *nodes.Node) error { return n.DeauthorizeDevice(ctx, "d1") }},
+		{"AddChildNode", func(n *nodes.Node) error {
+			return n.AddChildNode(ctx, []nodes.ChildNodeRequest{{NodeId: "c1", Alias: "child"}})
+		}},
+		{"ClearChildNodes", func(n *nodes.Node) error { return n.ClearChildNodes(ctx) }},
+		{"GetConnectionKey", func(n *nodes.Node) error { _, err := n.GetConnectionKey(ctx); return err }},
+		{"RegenerateConnectionKey", func(n *nodes.Node) error { _, err := n.RegenerateConnectionKey(ctx); return err }},
+		{"RemoveChildNode", func(n *nodes.Node) error { return n.RemoveChildNode(ctx, "c1") }},
+		{"FindOrphanChildren", func(n *nodes.Node) error { _, err := n.FindOrphanChildren(ctx); return err }},
+		{"BuildAliasIndex", func(n *nodes.Node) error { _, err := n.BuildAliasIndex(ctx); return err }},
+		{"WalkChildren", func(n *nodes.Node) error {
+			return n.WalkChildren(ctx, func(int, *nodes.Node) error { return nil })
+		}},
+	}
+
+	tests := []struct {
+		name    string
+		node    *nodes.Node
+		wantErr error
+	}{
+		{name: "zero node", node: &nodes.Node{}, wantErr: errors.ErrNodeIDNotSet},
+		{name: "unbound node", node: &nodes.Node{NodeId: "n1"}, wantErr: errors.ErrNodeManagementNotInitialized},
+	}
+
+	for _, tt := range tests {
+		for _, w := range wrappers {
+			t.Run(tt.name+"/"+w.name, func(t *testing.T) {
+				if err := w.call(tt.node); !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("%s() error = %v, want %v", w.name, err, tt.wantErr)
+				}
+			})
+		}
+	}
+}
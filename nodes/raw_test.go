@@ -0,0 +1,118 @@
+package nodes_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// rawCall invokes a *Raw method and reports its response and error.
+type rawCall func(ctx context.Context, nm *nodes.NodeManagement) (*http.Response, error)
+
+func TestRawVariants(t *testing.T) {
+	methods := []struct {
+		name     string
+		endpoint string
+		body     string
+		call     rawCall
+		invalid  rawCall
+	}{
+		{
+			name:     "GetNodeListRaw",
+			endpoint: common.EndpointNodeList,
+			body:     `{"success":true,"currentCount":1,"totalCount":1,"nodes":["n1"]}`,
+			call: func(ctx context.Context, nm *nodes.NodeManagement) (*http.Response, error) {
+				_, resp, err := nm.GetNodeListRaw(ctx, &nodes.GetNodeListRequest{Limit: 10, Order: "asc"})
+				return resp, err
+			},
+			invalid: func(ctx context.Context, nm *nodes.NodeManagement) (*http.Response, error) {
+				_, resp, err := nm.GetNodeListRaw(ctx, &nodes.GetNodeListRequest{})
+				return resp, err
+			},
+		},
+		{
+			name:     "GetNodeDetailsRaw",
+			endpoint: common.EndpointNodeDetails,
+			body:     `{"success":true,"data":{"n1":{"nodeId":"n1"}}}`,
+			call: func(ctx context.Context, nm *nodes.NodeManagement) (*http.Response, error) {
+				_, resp, err := nm.GetNodeDetailsRaw(ctx, &nodes.GetNodeDetailsRequest{Nodes: []string{"n1"}})
+				return resp, err
+			},
+			invalid: func(ctx context.Context, nm *nodes.NodeManagement) (*http.Response, error) {
+				_, resp, err := nm.GetNodeDetailsRaw(ctx, nil)
+				return resp, err
+			},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		status  int
+		body    string // empty to use the method's success body
+		wantErr bool
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "api error", status: http.StatusTooManyRequests, body: `{"success":false,"error":"slow down"}`, wantErr: true},
+	}
+
+	for _, m := range methods {
+		for _, tc := range cases {
+			t.Run(m.name+"/"+tc.name, func(t *testing.T) {
+				mock := anedyatest.NewServer()
+				defer mock.Close()
+
+				body := tc.body
+				if body == "" {
+					body = m.body
+				}
+				mock.Handle("/"+common.APIVersion+"/"+m.endpoint, func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-Request-Id", "req-123")
+					w.Header().Set("X-RateLimit-Remaining", "41")
+					w.WriteHeader(tc.status)
+					w.Write([]byte(body))
+				})
+
+				client := mock.Client()
+				defer client.Close()
+
+				resp, err := m.call(context.Background(), client.NodeManagement)
+				if tc.wantErr != (err != nil) {
+					t.Fatalf("error = %v, want error: %v", err, tc.wantErr)
+				}
+				if resp == nil {
+					t.Fatal("response is nil")
+				}
+				if resp.StatusCode != tc.status {
+					t.Fatalf("StatusCode = %d, want %d", resp.StatusCode, tc.status)
+				}
+				if got := resp.Header.Get("X-Request-Id"); got != "req-123" {
+					t.Fatalf("X-Request-Id = %q, want %q", got, "req-123")
+				}
+				if got := resp.Header.Get("X-RateLimit-Remaining"); got != "41" {
+					t.Fatalf("X-RateLimit-Remaining = %q, want %q", got, "41")
+				}
+				if b, _ := io.ReadAll(resp.Body); len(b) != 0 {
+					t.Fatalf("body is readable (%q), want it drained", b)
+				}
+			})
+		}
+
+		t.Run(m.name+"/invalid request", func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			client := mock.Client()
+			defer client.Close()
+
+			resp, err := m.invalid(context.Background(), client.NodeManagement)
+			if err == nil || resp != nil {
+				t.Fatalf("got %v, %v, want a nil response and an error", resp, err)
+			}
+		})
+	}
+}
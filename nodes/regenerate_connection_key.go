@@ -0,0 +1,48 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// RegenerateConnectionKey rotates the connection key of a node and
+// returns the new key.
+//
+// The key is regenerated with an UpdateRegenerateKey update, which the
+// API may acknowledge with an empty body, and the new key is then read
+// back with GetConnectionKey.
+//
+// Parameters:
+//   - ctx: Context for controlling request cancellation and timeout.
+//   - nodeID: ID of the node whose connection key is regenerated.
+//
+// Returns:
+//   - string: The new connection key.
+//   - error: *errors.AnedyaError if validation, network, or API errors occur.
+func (nm *NodeManagement) RegenerateConnectionKey(ctx context.Context, nodeID string) (string, error) {
+
+	// Validate NodeID
+	if nodeID == "" {
+		return "", &errors.AnedyaError{
+			Message: "nodeID is required to regenerate connection key",
+			Err:     errors.ErrRegenerateConnectionKeyNodeIDRequired,
+		}
+	}
+
+	// Regenerate the key
+	err := nm.UpdateNode(ctx, &UpdateNodeRequest{
+		NodeID: nodeID,
+		Updates: []NodeUpdate{
+			{Type: UpdateRegenerateKey},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Read back the new key
+	return nm.GetConnectionKey(ctx, &GetConnectionKeyRequest{
+		NodeID: nodeID,
+	})
+}
@@ -0,0 +1,107 @@
+package nodes_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+func TestRegenerateConnectionKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		updateBody string
+		wantKey    string
+		wantErr    error
+		wantCalls  []string
+	}{
+		{
+			name:      "empty update acknowledgement",
+			wantKey:   "key-2",
+			wantCalls: []string{common.EndpointNodeUpdate, common.EndpointNodeGetConnectionKey},
+		},
+		{
+			name:       "JSON update acknowledgement",
+			updateBody: `{"success":true}`,
+			wantKey:    "key-2",
+			wantCalls:  []string{common.EndpointNodeUpdate, common.EndpointNodeGetConnectionKey},
+		},
+		{
+			name:       "update fails",
+			updateBody: `{"success":false,"error":"node not found","reasonCode":"` + string(errors.ReasonNodeNotFound) + `"}`,
+			wantErr:    errors.ErrNodeNotFound,
+			wantCalls:  []string{common.EndpointNodeUpdate},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var calls []string
+			var update nodes.UpdateNodeRequest
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeUpdate, func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, common.EndpointNodeUpdate)
+				json.NewDecoder(r.Body).Decode(&update)
+				w.Write([]byte(tt.updateBody))
+			})
+			var keyReq nodes.GetConnectionKeyRequest
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeGetConnectionKey, func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, common.EndpointNodeGetConnectionKey)
+				json.NewDecoder(r.Body).Decode(&keyReq)
+				w.Write([]byte(`{"success":true,"connectionKey":"key-2"}`))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			key, err := client.NodeManagement.RegenerateConnectionKey(context.Background(), "n1")
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("RegenerateConnectionKey() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("RegenerateConnectionKey() error = %v", err)
+			}
+			if key != tt.wantKey {
+				t.Fatalf("key = %q, want %q", key, tt.wantKey)
+			}
+
+			if len(calls) != len(tt.wantCalls) {
+				t.Fatalf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			for i := range calls {
+				if calls[i] != tt.wantCalls[i] {
+					t.Fatalf("calls = %v, want %v", calls, tt.wantCalls)
+				}
+			}
+
+			if update.NodeID != "n1" || len(update.Updates) != 1 || update.Updates[0].Type != nodes.UpdateRegenerateKey {
+				t.Fatalf("update request = %+v, want a single regenerate_key update of n1", update)
+			}
+			if len(calls) > 1 && keyReq.NodeID != "n1" {
+				t.Fatalf("connection key requested for %q, want n1", keyReq.NodeID)
+			}
+		})
+	}
+}
+
+func TestRegenerateConnectionKeyRequiresNodeID(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	_, err := client.NodeManagement.RegenerateConnectionKey(context.Background(), "")
+	if !stderrors.Is(err, errors.ErrRegenerateConnectionKeyNodeIDRequired) {
+		t.Fatalf("RegenerateConnectionKey() error = %v, want %v", err, errors.ErrRegenerateConnectionKeyNodeIDRequired)
+	}
+}
@@ -0,0 +1,81 @@
+package nodes
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+)
+
+// SkipSubtree can be returned by the visit function passed to
+// WalkChildren to skip the descendants of the node just visited
+// without stopping the rest of the walk.
+var SkipSubtree = stderrors.New("skip this subtree")
+
+// WalkChildren traverses all descendants of this node depth-first,
+// calling visit for each of them.
+//
+// Direct children are visited at depth 1, their children at depth 2,
+// and so on. Nodes passed to visit carry the child ID and alias as
+// NodeId and NodeName and are bound to the same NodeManagement client.
+//
+// If visit returns SkipSubtree, or an error wrapping it, the
+// descendants of that node are not visited; any other non-nil error
+// stops the walk and is returned.
+// Every node is visited at most once, so a misconfigured hierarchy
+// containing cycles cannot cause an infinite walk.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout
+//   - visit: Function called for each descendant
+//
+// Returns:
+//   - error: Error if NodeManagement is nil, NodeId is empty, an API call fails, or visit fails
+func (n *Node) WalkChildren(ctx context.Context, visit func(depth int, n *Node) error) error {
+	if err := n.validate(); err != nil {
+		return err
+	}
+
+	visited := map[string]bool{n.NodeId: true}
+	return n.walkChildren(ctx, 1, visited, visit)
+}
+
+// walkChildren visits the children of n at the given depth and
+// recurses into their subtrees.
+func (n *Node) walkChildren(
+	ctx context.Context,
+	depth int,
+	visited map[string]bool,
+	visit func(depth int, n *Node) error,
+) error {
+	children, err := n.nodeManagement.AllChildNodes(ctx, n.NodeId)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		if visited[child.ChildId] {
+			continue
+		}
+		visited[child.ChildId] = true
+
+		node := &Node{
+			NodeId:         child.ChildId,
+			NodeName:       child.Alias,
+			CreatedAt:      fmt.Sprintf("%d", child.CreatedAt),
+			nodeManagement: n.nodeManagement,
+		}
+
+		if err := visit(depth, node); err != nil {
+			if stderrors.Is(err, SkipSubtree) {
+				continue
+			}
+			return err
+		}
+
+		if err := node.walkChildren(ctx, depth+1, visited, visit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
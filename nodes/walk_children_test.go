@@ -0,0 +1,153 @@
+package nodes_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/nodes"
+)
+
+// handleTree serves the List Child Nodes endpoint from a map of parent
+// ID to child IDs, returning every child in a single page.
+func handleTree(mock *anedyatest.MockServer, tree map[string][]string) {
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointNodeChildList, func(w http.ResponseWriter, r *http.Request) {
+		var req nodes.ListChildNodesRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		var children []nodes.ChildNode
+		for _, id := range page(tree[req.ParentId], req.Offset, req.Limit) {
+			children = append(children, nodes.ChildNode{ChildId: id, Alias: "alias-" + id})
+		}
+		json.NewEncoder(w).Encode(&nodes.ListChildNodesResponse{
+			Success:    true,
+			TotalCount: len(tree[req.ParentId]),
+			Count:      len(children),
+			Next:       req.Offset + len(children),
+			Data:       children,
+		})
+	})
+}
+
+func TestWalkChildren(t *testing.T) {
+	// root
+	// ├── a
+	// │   ├── a1
+	// │   │   └── a1x
+	// │   └── a2
+	// └── b
+	//     └── b1 -> root (cycle)
+	tree := map[string][]string{
+		"root": {"a", "b"},
+		"a":    {"a1", "a2"},
+		"a1":   {"a1x"},
+		"b":    {"b1"},
+		"b1":   {"root", "a"},
+	}
+
+	errStop := stderrors.New("stop")
+
+	tests := []struct {
+		name string
+		// ret returns the visit result for a node.
+		ret     func(id string) error
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "visits every descendant depth-first",
+			ret:  func(string) error { return nil },
+			want: []string{"1:a", "2:a1", "3:a1x", "2:a2", "1:b", "2:b1"},
+		},
+		{
+			name: "skip subtree",
+			ret: func(id string) error {
+				if id == "a" {
+					return nodes.SkipSubtree
+				}
+				return nil
+			},
+			want: []string{"1:a", "1:b", "2:b1"},
+		},
+		{
+			name: "wrapped skip subtree",
+			ret: func(id string) error {
+				if id == "a1" {
+					return fmt.Errorf("pruning %s: %w", id, nodes.SkipSubtree)
+				}
+				return nil
+			},
+			want: []string{"1:a", "2:a1", "2:a2", "1:b", "2:b1"},
+		},
+		{
+			name: "other error stops the walk",
+			ret: func(id string) error {
+				if id == "a1" {
+					return errStop
+				}
+				return nil
+			},
+			want:    []string{"1:a", "2:a1"},
+			wantErr: errStop,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			client := mock.Client()
+			defer client.Close()
+
+			root := boundNode(t, mock, client.NodeManagement, "root")
+			handleTree(mock, tree)
+
+			var got []string
+			err := root.WalkChildren(context.Background(), func(depth int, n *nodes.Node) error {
+				got = append(got, fmt.Sprintf("%d:%s", depth, n.NodeId))
+				if n.NodeName != "alias-"+n.NodeId {
+					t.Errorf("node %s NodeName = %q, want its alias", n.NodeId, n.NodeName)
+				}
+				return tt.ret(n.NodeId)
+			})
+			if !stderrors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("WalkChildren() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("visited %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWalkChildrenBoundNodes(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	client := mock.Client()
+	defer client.Close()
+
+	root := boundNode(t, mock, client.NodeManagement, "root")
+	handleTree(mock, map[string][]string{"root": {"a"}, "a": {"a1", "a2"}})
+
+	// nodes passed to visit can make API calls of their own
+	counts := map[string]int{}
+	err := root.WalkChildren(context.Background(), func(depth int, n *nodes.Node) error {
+		count, err := n.ChildCount(context.Background())
+		counts[n.NodeId] = count
+		return err
+	})
+	if err != nil {
+		t.Fatalf("WalkChildren() error = %v", err)
+	}
+	if counts["a"] != 2 || counts["a1"] != 0 || counts["a2"] != 0 {
+		t.Fatalf("child counts = %v, want a:2 a1:0 a2:0", counts)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -152,8 +153,9 @@ type CreateVariableResponse struct {
 //  4. Reads and decodes the API response.
 //  5. Maps API errors into structured SDK errors.
 //
-// Validation errors are returned as sentinel errors defined in the
-// errors package. All other failures return *errors.AnedyaError.
+// All failures return *errors.AnedyaError wrapping a sentinel error
+// defined in the errors package, so callers can match them with
+// errors.Is.
 func (v *VariableManagement) CreateVariable(ctx context.Context, input *CreateVariableRequest) (*Variable, error) {
 
 	// 1. Validate input payload.
@@ -183,7 +185,10 @@ func (v *VariableManagement) CreateVariable(ctx context.Context, input *CreateVa
 		"float": true,
 	}
 	if !validTypes[strings.ToLower(input.Type)] {
-		return nil, errors.ErrVariableTypeRequired
+		return nil, &errors.AnedyaError{
+			Message: "Input type must be either 'geo' or 'float'",
+			Err:     errors.ErrVariableTypeRequired,
+		}
 	}
 
 	// 2. Encode request body.
@@ -196,7 +201,13 @@ func (v *VariableManagement) CreateVariable(ctx context.Context, input *CreateVa
 	}
 
 	// 3. Build HTTP request.
-	url := fmt.Sprintf("%s/v1/variables/create", v.baseURL)
+	url, err := common.JoinURL(v.baseURL, common.APIVersion, common.EndpointVariableCreate)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build CreateVariable request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, &errors.AnedyaError{
@@ -205,15 +216,14 @@ func (v *VariableManagement) CreateVariable(ctx context.Context, input *CreateVa
 		}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	common.SetJSONHeaders(req)
 
 	// 4. Execute request.
 	resp, err := v.httpClient.Do(req)
 	if err != nil {
 		return nil, &errors.AnedyaError{
 			Message: "failed to execute CreateVariable request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
@@ -231,21 +241,25 @@ func (v *VariableManagement) CreateVariable(ctx context.Context, input *CreateVa
 	var apiResp CreateVariableResponse
 	if err := json.Unmarshal(body, &apiResp); err != nil {
 		return nil, &errors.AnedyaError{
-			Message: "failed to decode CreateVariable response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode CreateVariable response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    body,
 		}
 	}
 
 	// 7. Handle HTTP-level errors.
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
 	}
 
 	// 8. Handle API-level errors.
 	if !apiResp.Success {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
 	}
 
+	common.CaptureDecoded(resp, body)
+
 	// 9. Return created variable.
 	return &Variable{
 		variableManagement: v,
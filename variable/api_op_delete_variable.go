@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -74,7 +75,13 @@ func (v *VariableManagement) DeleteVariable(ctx context.Context, variable string
 	}
 
 	// 3. Build HTTP request
-	url := fmt.Sprintf("%s/v1/variables/delete", v.baseURL)
+	url, err := common.JoinURL(v.baseURL, common.APIVersion, common.EndpointVariableDelete)
+	if err != nil {
+		return &errors.AnedyaError{
+			Message: "failed to build DeleteVariable request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
@@ -88,15 +95,14 @@ func (v *VariableManagement) DeleteVariable(ctx context.Context, variable string
 		}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	common.SetJSONHeaders(req)
 
 	// 4. Execute request
 	resp, err := v.httpClient.Do(req)
 	if err != nil {
 		return &errors.AnedyaError{
 			Message: "failed to execute DeleteVariable request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
@@ -110,24 +116,33 @@ func (v *VariableManagement) DeleteVariable(ctx context.Context, variable string
 		}
 	}
 
+	// An empty 2xx body acknowledges the operation without data
+	if common.IsEmptySuccess(resp, body) {
+		return nil
+	}
+
 	// 6. Decode API response
 	var apiResp DeleteVariableResponse
 	if err := json.Unmarshal(body, &apiResp); err != nil {
 		return &errors.AnedyaError{
-			Message: "failed to decode DeleteVariable response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode DeleteVariable response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    body,
 		}
 	}
 
 	// 7. Handle HTTP-level errors
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
 	}
 
 	// 8. Handle API-level errors
 	if !apiResp.Success {
-		return errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
 	}
 
+	common.CaptureDecoded(resp, body)
+
 	return nil
 }
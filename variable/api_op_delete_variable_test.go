@@ -0,0 +1,50 @@
+package variable_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+func TestDeleteVariableEmptySuccessBodies(t *testing.T) {
+	bodies := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{name: "empty 200", status: http.StatusOK},
+		{name: "empty 204", status: http.StatusNoContent},
+		{name: "JSON success", status: http.StatusOK, body: `{"success":true}`},
+		{name: "empty error status", status: http.StatusInternalServerError, wantErr: errors.ErrResponseDecodeFailed},
+		{name: "JSON failure", status: http.StatusOK, body: `{"success":false,"error":"boom"}`, wantErr: errors.ErrUnknown},
+	}
+
+	for _, b := range bodies {
+		t.Run(b.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointVariableDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(b.status)
+				w.Write([]byte(b.body))
+			})
+
+			client := mock.Client()
+			defer client.Close()
+
+			err := client.VariableManagement.DeleteVariable(context.Background(), "temp")
+			if b.wantErr == nil && err != nil {
+				t.Fatalf("DeleteVariable() error = %v, want nil", err)
+			}
+			if b.wantErr != nil && !stderrors.Is(err, b.wantErr) {
+				t.Fatalf("DeleteVariable() error = %v, want %v", err, b.wantErr)
+			}
+		})
+	}
+}
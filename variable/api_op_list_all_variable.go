@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/anedyaio/anedya-go-sdk/common"
 	"github.com/anedyaio/anedya-go-sdk/errors"
 )
 
@@ -117,20 +118,39 @@ type ListVariablesResult struct {
 // Validation and transport failures are returned as structured SDK errors
 // using *errors.AnedyaError. API-level failures are mapped using the
 // error reason codes returned by the server.
+//
+// ListAllVariable is kept for compatibility; new code should prefer
+// ListVariables.
 func (v *VariableManagement) ListAllVariable(ctx context.Context, limit int, offset int) (*ListVariablesResult, error) {
+	return v.ListVariables(ctx, common.WithLimit(limit), common.WithOffset(offset))
+}
+
+// ListVariables retrieves a paginated list of variables from the
+// Anedya platform using functional list options.
+//
+//	res, err := vm.ListVariables(ctx, common.WithLimit(50), common.WithOffset(100))
+//
+// Only the Limit and Offset options are honoured; ordering options
+// are ignored because the List Variables API does not support them.
+// A limit of zero or less uses the default of 100 and a negative
+// offset is treated as zero.
+//
+// See ListAllVariable for the result and error semantics.
+func (v *VariableManagement) ListVariables(ctx context.Context, opts ...common.ListOption) (*ListVariablesResult, error) {
 
 	// 1. Validate and normalize inputs
-	if limit <= 0 {
-		limit = 100
+	o := common.ApplyListOptions(opts...)
+	if o.Limit <= 0 {
+		o.Limit = 100
 	}
-	if offset < 0 {
-		offset = 0
+	if o.Offset < 0 {
+		o.Offset = 0
 	}
 
 	// 2. Prepare request payload
 	reqPayload := ListAllVariableRequest{
-		Limit:  limit,
-		OffSet: offset,
+		Limit:  o.Limit,
+		OffSet: o.Offset,
 	}
 
 	requestBody, err := json.Marshal(reqPayload)
@@ -142,7 +162,13 @@ func (v *VariableManagement) ListAllVariable(ctx context.Context, limit int, off
 	}
 
 	// 3. Build HTTP request
-	url := fmt.Sprintf("%s/v1/variables/list", v.baseURL)
+	url, err := common.JoinURL(v.baseURL, common.APIVersion, common.EndpointVariableList)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build ListAllVariable request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
@@ -156,15 +182,14 @@ func (v *VariableManagement) ListAllVariable(ctx context.Context, limit int, off
 		}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	common.SetJSONHeaders(req)
 
 	// 4. Execute request
 	resp, err := v.httpClient.Do(req)
 	if err != nil {
 		return nil, &errors.AnedyaError{
 			Message: "failed to execute ListAllVariable request",
-			Err:     errors.ErrRequestFailed,
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
 		}
 	}
 	defer resp.Body.Close()
@@ -182,21 +207,25 @@ func (v *VariableManagement) ListAllVariable(ctx context.Context, limit int, off
 	var apiResp ListAllVariableResponse
 	if err := json.Unmarshal(body, &apiResp); err != nil {
 		return nil, &errors.AnedyaError{
-			Message: "failed to decode ListAllVariable response",
-			Err:     errors.ErrResponseDecodeFailed,
+			Message:    "failed to decode ListAllVariable response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    body,
 		}
 	}
 
 	// 7. Handle HTTP-level errors
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
 	}
 
 	// 8. Handle API-level errors
 	if !apiResp.Success {
-		return nil, errors.GetError(apiResp.ReasonCode, apiResp.Error)
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
 	}
 
+	common.CaptureDecoded(resp, body)
+
 	// 9. Convert API response objects to SDK variables
 	variables := make([]Variable, len(apiResp.NodeParams))
 	for i, item := range apiResp.NodeParams {
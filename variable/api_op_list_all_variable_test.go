@@ -0,0 +1,103 @@
+package variable_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/variable"
+)
+
+func TestListVariables(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(ctx context.Context, vm *variable.VariableManagement) (*variable.ListVariablesResult, error)
+		want      variable.ListAllVariableRequest
+		wantCount int
+	}{
+		{
+			name: "defaults",
+			call: func(ctx context.Context, vm *variable.VariableManagement) (*variable.ListVariablesResult, error) {
+				return vm.ListVariables(ctx)
+			},
+			want:      variable.ListAllVariableRequest{Limit: 100},
+			wantCount: 5,
+		},
+		{
+			name: "options",
+			call: func(ctx context.Context, vm *variable.VariableManagement) (*variable.ListVariablesResult, error) {
+				return vm.ListVariables(ctx, common.WithLimit(2), common.WithOffset(1), common.WithOrder("desc"))
+			},
+			want:      variable.ListAllVariableRequest{Limit: 2, OffSet: 1},
+			wantCount: 2,
+		},
+		{
+			name: "out of range values use defaults",
+			call: func(ctx context.Context, vm *variable.VariableManagement) (*variable.ListVariablesResult, error) {
+				return vm.ListVariables(ctx, common.WithLimit(-1), common.WithOffset(-3))
+			},
+			want:      variable.ListAllVariableRequest{Limit: 100},
+			wantCount: 5,
+		},
+		{
+			name: "positional wrapper",
+			call: func(ctx context.Context, vm *variable.VariableManagement) (*variable.ListVariablesResult, error) {
+				return vm.ListAllVariable(ctx, 2, 4)
+			},
+			want:      variable.ListAllVariableRequest{Limit: 2, OffSet: 4},
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var reqs []variable.ListAllVariableRequest
+			handleVariableList(mock, 5, &reqs)
+
+			client := mock.Client()
+			defer client.Close()
+
+			res, err := tt.call(context.Background(), client.VariableManagement)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(reqs) != 1 || reqs[0] != tt.want {
+				t.Fatalf("requests = %+v, want [%+v]", reqs, tt.want)
+			}
+			if len(res.Variables) != tt.wantCount || res.TotalCount != 5 {
+				t.Fatalf("got %d of %d variables, want %d of 5", len(res.Variables), res.TotalCount, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestListVariablesJSONHeaders(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	var header http.Header
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointVariableList, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	if _, err := client.VariableManagement.ListVariables(context.Background()); err != nil {
+		t.Fatalf("ListVariables() error = %v", err)
+	}
+	if header == nil {
+		t.Fatal("no request reached the server")
+	}
+	for _, name := range []string{"Content-Type", "Accept"} {
+		if got := header.Values(name); len(got) != 1 || got[0] != "application/json" {
+			t.Fatalf("%s = %v, want [application/json]", name, got)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Package variable provides APIs to manage variables in the Anedya platform.
+package variable
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// UpdateVariableRequest represents the payload sent to the
+// Update Variable API endpoint.
+//
+// The variable is identified by its key. Only fields that are set
+// are sent; at least one of Name, Description or TTL must be set.
+// Description and TTL are pointers so that an empty description or a
+// zero TTL can be sent explicitly.
+type UpdateVariableRequest struct {
+
+	// Variable specifies the unique variable key or path
+	// that identifies the variable to be updated.
+	//
+	// This field is required.
+	Variable string `json:"variable"`
+
+	// Name specifies the new human-readable name of the variable.
+	Name string `json:"name,omitempty"`
+
+	// Description specifies the new description of the variable.
+	// A nil Description leaves it unchanged.
+	Description *string `json:"desc,omitempty"`
+
+	// TTL specifies the new expiration time (in seconds)
+	// for values associated with this variable.
+	// A nil TTL leaves it unchanged.
+	TTL *int `json:"ttl,omitempty"`
+}
+
+// UpdateVariableResponse represents the response returned by
+// the Update Variable API endpoint.
+type UpdateVariableResponse struct {
+	BaseResponse
+}
+
+// UpdateVariable updates the name, description or TTL of an existing
+// variable without changing its VariableID.
+//
+// The method performs the following steps:
+//
+//  1. Validates the request payload.
+//  2. Encodes the payload as JSON.
+//  3. Builds and sends an HTTP request.
+//  4. Reads and decodes the API response.
+//  5. Maps API errors into structured SDK errors.
+//  6. Fetches the updated variable with GetVariable.
+//
+// All failures return *errors.AnedyaError wrapping a sentinel error
+// defined in the errors package, so callers can match them with
+// errors.Is.
+func (v *VariableManagement) UpdateVariable(ctx context.Context, input *UpdateVariableRequest) (*Variable, error) {
+
+	// 1. Validate input payload.
+	if input == nil {
+		return nil, &errors.AnedyaError{
+			Message: "Input is required",
+			Err:     errors.ErrInputRequired,
+		}
+	}
+
+	if input.Variable == "" {
+		return nil, &errors.AnedyaError{
+			Message: "Input variable is required",
+			Err:     errors.ErrVariableRequired,
+		}
+	}
+
+	if input.Name == "" && input.Description == nil && input.TTL == nil {
+		return nil, &errors.AnedyaError{
+			Message: "at least one of name, description or ttl must be updated",
+			Err:     errors.ErrVariableNoChanges,
+		}
+	}
+
+	if input.TTL != nil && *input.TTL < 0 {
+		return nil, errors.NewFieldError("ttl", *input.TTL, "must not be negative", errors.ErrInvalidInput)
+	}
+
+	// 2. Encode request body.
+	requestBody, err := json.Marshal(input)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to encode UpdateVariable request",
+			Err:     errors.ErrRequestEncodeFailed,
+		}
+	}
+
+	// 3. Build HTTP request.
+	url, err := common.JoinURL(v.baseURL, common.APIVersion, common.EndpointVariableUpdate)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build UpdateVariable request URL",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to build UpdateVariable request",
+			Err:     errors.ErrRequestBuildFailed,
+		}
+	}
+
+	common.SetJSONHeaders(req)
+
+	// 4. Execute request.
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to execute UpdateVariable request",
+			Err:     fmt.Errorf("%w: %w", errors.ErrRequestFailed, err),
+		}
+	}
+	defer resp.Body.Close()
+
+	// 5. Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &errors.AnedyaError{
+			Message: "failed to read UpdateVariable response",
+			Err:     errors.ErrResponseReadFailed,
+		}
+	}
+
+	// 6. Decode response
+	var apiResp UpdateVariableResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, &errors.AnedyaError{
+			Message:    "failed to decode UpdateVariable response",
+			Err:        errors.ErrResponseDecodeFailed,
+			StatusCode: resp.StatusCode,
+			RawBody:    body,
+		}
+	}
+
+	// 7. Handle HTTP-level errors.
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
+	}
+
+	// 8. Handle API-level errors.
+	if !apiResp.Success {
+		return nil, errors.GetErrorWithResponse(apiResp.ReasonCode, apiResp.Error, resp.StatusCode, body)
+	}
+
+	common.CaptureDecoded(resp, body)
+
+	// 9. Return the updated variable, including unchanged fields.
+	return v.GetVariable(ctx, input.Variable)
+}
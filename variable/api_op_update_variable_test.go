@@ -0,0 +1,95 @@
+package variable_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/variable"
+)
+
+func TestUpdateVariable(t *testing.T) {
+	empty, zero, ttl, negative := "", 0, 3600, -1
+
+	tests := []struct {
+		name      string
+		req       variable.UpdateVariableRequest
+		wantSent  map[string]interface{} // nil when nothing is sent
+		wantErr   error
+		wantField string
+	}{
+		{
+			name:     "name only",
+			req:      variable.UpdateVariableRequest{Variable: "var0", Name: "Temperature"},
+			wantSent: map[string]interface{}{"variable": "var0", "name": "Temperature"},
+		},
+		{
+			name:     "ttl",
+			req:      variable.UpdateVariableRequest{Variable: "var0", TTL: &ttl},
+			wantSent: map[string]interface{}{"variable": "var0", "ttl": float64(3600)},
+		},
+		{
+			name:     "clear description",
+			req:      variable.UpdateVariableRequest{Variable: "var0", Description: &empty},
+			wantSent: map[string]interface{}{"variable": "var0", "desc": ""},
+		},
+		{
+			name:     "zero ttl",
+			req:      variable.UpdateVariableRequest{Variable: "var0", TTL: &zero},
+			wantSent: map[string]interface{}{"variable": "var0", "ttl": float64(0)},
+		},
+		{
+			name:    "no changes",
+			req:     variable.UpdateVariableRequest{Variable: "var0"},
+			wantErr: errors.ErrVariableNoChanges,
+		},
+		{
+			name:      "negative ttl",
+			req:       variable.UpdateVariableRequest{Variable: "var0", TTL: &negative},
+			wantErr:   errors.ErrInvalidInput,
+			wantField: "ttl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var sent map[string]interface{}
+			mock.Handle("/"+common.APIVersion+"/"+common.EndpointVariableUpdate, func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&sent)
+				w.Write([]byte(`{"success":true}`))
+			})
+			handleVariableList(mock, 1, nil)
+
+			client := mock.Client()
+			defer client.Close()
+
+			req := tt.req
+			v, err := client.VariableManagement.UpdateVariable(context.Background(), &req)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateVariable() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantField != "" {
+				var fe *errors.FieldError
+				if !stderrors.As(err, &fe) || fe.Field != tt.wantField {
+					t.Fatalf("UpdateVariable() error = %v, want a FieldError on %q", err, tt.wantField)
+				}
+			}
+			if tt.wantErr == nil && (v == nil || v.Variable != "var0") {
+				t.Fatalf("UpdateVariable() = %+v, want the updated variable var0", v)
+			}
+
+			if !reflect.DeepEqual(sent, tt.wantSent) {
+				t.Fatalf("sent %v, want %v", sent, tt.wantSent)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package variable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anedyaio/anedya-go-sdk/errors"
+)
+
+// GetVariable retrieves a single variable by its key.
+//
+// The Anedya API has no variable details endpoint, so the variable
+// list is paged through with a VariableIterator until a variable whose
+// Variable key matches is found. The returned Variable is bound to
+// this VariableManagement client.
+//
+// Input:
+//
+//   - variable is the variable key to look up. It must not be empty.
+//
+// Output:
+//
+//   - On success, the matching *Variable including its ID, name,
+//     type, description and TTL.
+//
+//   - When no variable has the given key, an *errors.AnedyaError
+//     wrapping errors.ErrVariableNotFound.
+//
+//   - On other failures, the validation, transport or API error.
+func (v *VariableManagement) GetVariable(ctx context.Context, variable string) (*Variable, error) {
+
+	// 1. Validate input
+	if variable == "" {
+		return nil, &errors.AnedyaError{
+			Message: "variable key is required",
+			Err:     errors.ErrVariableRequired,
+		}
+	}
+
+	// 2. Page through variables until the key matches
+	it := v.ListAllVariablesIterator(ctx, 100)
+	for it.Next() {
+		if it.Value().Variable == variable {
+			found := it.Value()
+			return &found, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	// 3. Report a missing variable
+	return nil, &errors.AnedyaError{
+		Message: fmt.Sprintf("variable %q not found", variable),
+		Err:     errors.ErrVariableNotFound,
+	}
+}
@@ -0,0 +1,77 @@
+package variable_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/errors"
+	"github.com/anedyaio/anedya-go-sdk/variable"
+)
+
+func TestGetVariable(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		wantID       string
+		wantErr      error
+		wantRequests int
+	}{
+		{name: "first page", key: "var3", wantID: "v3", wantRequests: 1},
+		{name: "later page", key: "var120", wantID: "v120", wantRequests: 2},
+		{name: "not found", key: "missing", wantErr: errors.ErrVariableNotFound, wantRequests: 2},
+		{name: "empty key", key: "", wantErr: errors.ErrVariableRequired, wantRequests: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var reqs []variable.ListAllVariableRequest
+			handleVariableList(mock, 150, &reqs)
+
+			client := mock.Client()
+			defer client.Close()
+
+			v, err := client.VariableManagement.GetVariable(context.Background(), tt.key)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("GetVariable() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if v != nil {
+					t.Fatalf("GetVariable() = %+v, want nil", v)
+				}
+			} else if v == nil || v.VariableID != tt.wantID || v.Variable != tt.key {
+				t.Fatalf("GetVariable() = %+v, want variable %q with ID %q", v, tt.key, tt.wantID)
+			}
+			if len(reqs) != tt.wantRequests {
+				t.Fatalf("made %d requests, want %d", len(reqs), tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestGetVariableListError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointVariableList, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"success":false,"error":"boom"}`))
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	_, err := client.VariableManagement.GetVariable(context.Background(), "var0")
+	if err == nil {
+		t.Fatal("GetVariable() error = nil, want the list error")
+	}
+	if stderrors.Is(err, errors.ErrVariableNotFound) {
+		t.Fatalf("GetVariable() error = %v, want the list error rather than not found", err)
+	}
+}
@@ -0,0 +1,144 @@
+package variable
+
+import "context"
+
+// VariableIterator pages through all variables using ListAllVariable.
+//
+// Use Next to advance the iterator and Value to read the current
+// variable. Once Next returns false, Err reports the error that
+// stopped the iteration, if any.
+//
+//	it := vm.ListAllVariablesIterator(ctx, 100)
+//	for it.Next() {
+//		v := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type VariableIterator struct {
+	// OnProgress, when non-nil, is called after every page with the
+	// number of variables fetched so far and the total reported by the API.
+	OnProgress func(fetched, total int)
+
+	ctx      context.Context
+	vm       *VariableManagement
+	pageSize int
+
+	// offset is the offset of the next page to fetch.
+	offset int
+
+	// fetched is the number of items fetched so far.
+	fetched int
+
+	page    []Variable
+	index   int
+	current Variable
+
+	// done is set once the last page has been fetched.
+	done bool
+	err  error
+}
+
+// ListAllVariablesIterator returns an iterator over all variables,
+// fetching pageSize variables per request.
+//
+// Subsequent pages are requested by advancing the offset by the
+// number of variables returned until TotalCount is reached. Iteration
+// also stops on an empty page, so a misreported TotalCount cannot
+// cause an infinite loop.
+//
+// A pageSize of zero or less uses the ListAllVariable default of 100.
+func (v *VariableManagement) ListAllVariablesIterator(ctx context.Context, pageSize int) *VariableIterator {
+	return &VariableIterator{
+		ctx:      ctx,
+		vm:       v,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances the iterator to the next variable.
+//
+// It returns false when all variables have been read or an error
+// occurred; use Err to tell the two apart.
+func (it *VariableIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the variable at the current iterator position.
+func (it *VariableIterator) Value() Variable {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+//
+// Context cancellation is reported here as well.
+func (it *VariableIterator) Err() error {
+	return it.err
+}
+
+// fetch loads the next page and reports whether it contains
+// any variables.
+func (it *VariableIterator) fetch() bool {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	res, err := it.vm.ListAllVariable(it.ctx, it.pageSize, it.offset)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = res.Variables
+	it.index = 0
+
+	// an empty page ends the iteration regardless of TotalCount
+	if len(res.Variables) == 0 {
+		it.done = true
+		return false
+	}
+
+	it.offset += len(res.Variables)
+	if it.offset >= res.TotalCount {
+		it.done = true
+	}
+
+	it.fetched += len(res.Variables)
+	if it.OnProgress != nil {
+		it.OnProgress(it.fetched, res.TotalCount)
+	}
+
+	return true
+}
+
+// AllVariables retrieves every variable by draining a
+// VariableIterator into a slice.
+//
+// To report progress while collecting, create an iterator with
+// ListAllVariablesIterator, set its OnProgress and drain it directly.
+func (v *VariableManagement) AllVariables(ctx context.Context) ([]Variable, error) {
+	it := v.ListAllVariablesIterator(ctx, 100)
+
+	var variables []Variable
+	for it.Next() {
+		variables = append(variables, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
@@ -0,0 +1,230 @@
+package variable_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/anedyaio/anedya-go-sdk/anedyatest"
+	"github.com/anedyaio/anedya-go-sdk/common"
+	"github.com/anedyaio/anedya-go-sdk/variable"
+)
+
+// handleVariableList serves total variables from the List Variables
+// endpoint, honouring the requested limit and offset, and records
+// every request it receives.
+func handleVariableList(mock *anedyatest.MockServer, total int, reqs *[]variable.ListAllVariableRequest) {
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointVariableList, func(w http.ResponseWriter, r *http.Request) {
+		var req variable.ListAllVariableRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if reqs != nil {
+			*reqs = append(*reqs, req)
+		}
+
+		var items []variable.VariableListItem
+		for i := req.OffSet; i < total && len(items) < req.Limit; i++ {
+			items = append(items, variable.VariableListItem{
+				VariableID: fmt.Sprintf("v%d", i),
+				Variable:   fmt.Sprintf("var%d", i),
+			})
+		}
+
+		json.NewEncoder(w).Encode(&variable.ListAllVariableResponse{
+			BaseResponse: variable.BaseResponse{Success: true},
+			CurrentCount: len(items),
+			OffSet:       req.OffSet,
+			TotalCount:   total,
+			NodeParams:   items,
+		})
+	})
+}
+
+func TestListAllVariablesIteratorOnProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		pageSize int
+	}{
+		{name: "several full pages", total: 6, pageSize: 2},
+		{name: "short last page", total: 7, pageSize: 3},
+		{name: "single page", total: 3, pageSize: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			handleVariableList(mock, tt.total, nil)
+
+			client := mock.Client()
+			defer client.Close()
+
+			var fetched, totals []int
+			it := client.VariableManagement.ListAllVariablesIterator(context.Background(), tt.pageSize)
+			it.OnProgress = func(f, total int) {
+				fetched = append(fetched, f)
+				totals = append(totals, total)
+			}
+
+			count := 0
+			for it.Next() {
+				count++
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if count != tt.total {
+				t.Fatalf("iterated %d variables, want %d", count, tt.total)
+			}
+
+			for i := range fetched {
+				if i > 0 && fetched[i] <= fetched[i-1] {
+					t.Fatalf("fetched = %v, want strictly increasing", fetched)
+				}
+				if totals[i] != tt.total {
+					t.Fatalf("totals = %v, want all %d", totals, tt.total)
+				}
+			}
+			if len(fetched) == 0 || fetched[len(fetched)-1] != tt.total {
+				t.Fatalf("fetched = %v, want it to end at %d", fetched, tt.total)
+			}
+		})
+	}
+}
+
+func TestListAllVariablesIterator(t *testing.T) {
+	tests := []struct {
+		name        string
+		total       int
+		pageSize    int
+		wantOffsets []int
+	}{
+		{name: "several pages", total: 5, pageSize: 2, wantOffsets: []int{0, 2, 4}},
+		{name: "stops at exact last page", total: 4, pageSize: 2, wantOffsets: []int{0, 2}},
+		{name: "no variables", total: 0, pageSize: 2, wantOffsets: []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := anedyatest.NewServer()
+			defer mock.Close()
+
+			var reqs []variable.ListAllVariableRequest
+			handleVariableList(mock, tt.total, &reqs)
+
+			client := mock.Client()
+			defer client.Close()
+
+			it := client.VariableManagement.ListAllVariablesIterator(context.Background(), tt.pageSize)
+
+			var got []string
+			for it.Next() {
+				got = append(got, it.Value().VariableID)
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+
+			if len(got) != tt.total {
+				t.Fatalf("iterated %v, want %d variables", got, tt.total)
+			}
+			for i, id := range got {
+				if want := fmt.Sprintf("v%d", i); id != want {
+					t.Fatalf("variable %d = %q, want %q", i, id, want)
+				}
+			}
+
+			var offsets []int
+			for _, req := range reqs {
+				offsets = append(offsets, req.OffSet)
+			}
+			if !reflect.DeepEqual(offsets, tt.wantOffsets) {
+				t.Fatalf("requested offsets %v, want %v", offsets, tt.wantOffsets)
+			}
+
+			// an exhausted iterator stays exhausted without new requests
+			if it.Next() {
+				t.Fatal("Next() = true after the last variable")
+			}
+			if len(reqs) != len(tt.wantOffsets) {
+				t.Fatalf("made %d requests, want %d", len(reqs), len(tt.wantOffsets))
+			}
+		})
+	}
+}
+
+func TestListAllVariablesIteratorError(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointVariableList, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"success":false,"error":"boom"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&variable.ListAllVariableResponse{
+			BaseResponse: variable.BaseResponse{Success: true},
+			CurrentCount: 2,
+			TotalCount:   10,
+			NodeParams:   []variable.VariableListItem{{VariableID: "v0"}, {VariableID: "v1"}},
+		})
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	it := client.VariableManagement.ListAllVariablesIterator(context.Background(), 2)
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 {
+		t.Fatalf("iterated %d variables, want the 2 of the first page", count)
+	}
+	if it.Err() == nil {
+		t.Fatal("Err() = nil, want the second page error")
+	}
+	if it.Next() {
+		t.Fatal("Next() = true after an error")
+	}
+	if requests != 2 {
+		t.Fatalf("made %d requests, want 2", requests)
+	}
+}
+
+func TestListAllVariablesIteratorEmptyPage(t *testing.T) {
+	mock := anedyatest.NewServer()
+	defer mock.Close()
+
+	requests := 0
+	mock.Handle("/"+common.APIVersion+"/"+common.EndpointVariableList, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		// TotalCount claims more variables than are ever returned
+		resp := variable.ListAllVariableResponse{BaseResponse: variable.BaseResponse{Success: true}, TotalCount: 100}
+		if requests == 1 {
+			resp.CurrentCount = 1
+			resp.NodeParams = []variable.VariableListItem{{VariableID: "v0"}}
+		}
+		json.NewEncoder(w).Encode(&resp)
+	})
+
+	client := mock.Client()
+	defer client.Close()
+
+	vars, err := client.VariableManagement.AllVariables(context.Background())
+	if err != nil {
+		t.Fatalf("AllVariables() error = %v", err)
+	}
+	if len(vars) != 1 || requests != 2 {
+		t.Fatalf("got %d variables in %d requests, want 1 in 2", len(vars), requests)
+	}
+}